@@ -0,0 +1,135 @@
+// Package businessdays counts working days on the B.S. calendar. Which days of
+// the week are off is configurable, since the Nepali working week has had
+// Saturday and at times also Sunday off, and public holidays are supplied by
+// holiday providers.
+package businessdays
+
+import (
+	"errors"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// ErrNoBusinessDays is the error returned when a calendar's weekend contains
+// every day of the week, so there are no business days to count.
+var ErrNoBusinessDays = errors.New("Weekend contains every day of the week")
+
+// Weekend is the set of weekdays that are off every week.
+type Weekend []nepcal.Weekday
+
+// Common weekends in Nepal.
+var (
+	// SaturdayWeekend has only Saturday off, which is the standard working week.
+	SaturdayWeekend = Weekend{nepcal.Saturday}
+
+	// SaturdaySundayWeekend has both Saturday and Sunday off, as has been the
+	// case for government offices at times.
+	SaturdaySundayWeekend = Weekend{nepcal.Saturday, nepcal.Sunday}
+)
+
+// Contains reports if the weekday is off.
+func (w Weekend) Contains(weekday nepcal.Weekday) bool {
+	for _, d := range w {
+		if d == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// full reports if every day of the week is off.
+func (w Weekend) full() bool {
+	for d := nepcal.Sunday; d <= nepcal.Saturday; d++ {
+		if !w.Contains(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Calendar decides which days are business days, i.e. days that are neither in
+// the weekend nor a holiday of any of the holiday providers.
+type Calendar struct {
+	Weekend  Weekend
+	Holidays []HolidayProvider
+}
+
+// New returns a calendar with the weekend and holiday providers.
+func New(weekend Weekend, holidays ...HolidayProvider) Calendar {
+	return Calendar{Weekend: weekend, Holidays: holidays}
+}
+
+// IsBusinessDay reports if 't' is a business day.
+func (c Calendar) IsBusinessDay(t nepcal.Time) bool {
+	if c.Weekend.Contains(t.Weekday()) {
+		return false
+	}
+
+	for _, h := range c.Holidays {
+		if h.IsHoliday(t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddBusinessDays returns the date 'n' business days after 't', or before it if
+// 'n' is negative; 't' itself is not counted. For example, adding 1 business
+// day to a Friday with a Saturday weekend results in the Sunday after it. 't'
+// is returned as is if 'n' is 0.
+//
+// An ErrOutOfBounds is returned if the result is outside the supported date
+// range, and an ErrNoBusinessDays if the weekend contains every day.
+func (c Calendar) AddBusinessDays(t nepcal.Time, n int) (nepcal.Time, error) {
+	if n == 0 {
+		return t, nil
+	}
+
+	if c.Weekend.full() {
+		return nepcal.Time{}, ErrNoBusinessDays
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		var err error
+		if t, err = t.AddDays(step); err != nil {
+			return nepcal.Time{}, err
+		}
+
+		if c.IsBusinessDay(t) {
+			n--
+		}
+	}
+
+	return t, nil
+}
+
+// BusinessDaysBetween returns the number of business days after 'from' up to
+// and including 'to', such that adding the result to 'from' with
+// AddBusinessDays results in 'to' whenever 'to' is a business day. If 'to' is
+// before 'from', the result is the negated number of business days from 'to'
+// up to but excluding 'from'.
+func (c Calendar) BusinessDaysBetween(from, to nepcal.Time) int {
+	first, last, sign := from.JulianDay()+1, to.JulianDay(), 1
+	if from.After(to) {
+		first, last, sign = to.JulianDay(), from.JulianDay()-1, -1
+	}
+
+	n := 0
+	for jdn := first; jdn <= last; jdn++ {
+		// Both ends are within the supported range, so every day between them is too.
+		t, _ := nepcal.FromJulianDay(jdn)
+		if c.IsBusinessDay(t) {
+			n++
+		}
+	}
+
+	return sign * n
+}
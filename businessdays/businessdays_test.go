@@ -0,0 +1,131 @@
+package businessdays
+
+import (
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBusinessDay(t *testing.T) {
+	holiday := NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 16))
+
+	tests := []struct {
+		name     string
+		calendar Calendar
+		t        nepcal.Time
+		expected bool
+	}{
+		{"weekday", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), true},
+		{"saturday", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 19), false},
+		{"sunday", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 20), true},
+		{"sunday off", New(SaturdaySundayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 20), false},
+		{"holiday", New(SaturdayWeekend, holiday), nepcal.DateUnchecked(2081, nepcal.Shrawan, 16), false},
+		{"no weekend", New(nil), nepcal.DateUnchecked(2081, nepcal.Shrawan, 19), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.calendar.IsBusinessDay(test.t))
+		})
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		t        nepcal.Time
+		n        int
+		expected nepcal.Time
+	}{
+		{"zero", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 19), 0, nepcal.DateUnchecked(2081, nepcal.Shrawan, 19)},
+		{"over saturday", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), 5, nepcal.DateUnchecked(2081, nepcal.Shrawan, 21)},
+		{"over the weekend", New(SaturdaySundayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), 5, nepcal.DateUnchecked(2081, nepcal.Shrawan, 22)},
+		{"over a holiday", New(SaturdayWeekend, NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 16))), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), 5, nepcal.DateUnchecked(2081, nepcal.Shrawan, 22)},
+		{"from a weekend", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 19), 1, nepcal.DateUnchecked(2081, nepcal.Shrawan, 20)},
+		{"backwards", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 21), -3, nepcal.DateUnchecked(2081, nepcal.Shrawan, 17)},
+		{"into the next month", New(SaturdayWeekend), nepcal.DateUnchecked(2081, nepcal.Shrawan, 30), 3, nepcal.DateUnchecked(2081, nepcal.Bhadra, 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.calendar.AddBusinessDays(test.t, test.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, err := New(SaturdayWeekend).AddBusinessDays(nepcal.DateUnchecked(2100, nepcal.Chaitra, 30), 1)
+		assert.Equal(t, nepcal.ErrOutOfBounds, err)
+	})
+
+	t.Run("no business days", func(t *testing.T) {
+		everyDay := Weekend{0, 1, 2, 3, 4, 5, 6}
+
+		_, err := New(everyDay).AddBusinessDays(nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), 1)
+		assert.Equal(t, ErrNoBusinessDays, err)
+	})
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	c := New(SaturdayWeekend, NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 23)))
+
+	tests := []struct {
+		name     string
+		from, to nepcal.Time
+		expected int
+	}{
+		{"same day", nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), 0},
+		{"over saturday", nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), nepcal.DateUnchecked(2081, nepcal.Shrawan, 21), 5},
+		{"over a holiday", nepcal.DateUnchecked(2081, nepcal.Shrawan, 21), nepcal.DateUnchecked(2081, nepcal.Shrawan, 25), 3},
+		{"saturday to sunday", nepcal.DateUnchecked(2081, nepcal.Shrawan, 19), nepcal.DateUnchecked(2081, nepcal.Shrawan, 20), 1},
+		{"backwards", nepcal.DateUnchecked(2081, nepcal.Shrawan, 21), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), -5},
+		{"backwards from a saturday", nepcal.DateUnchecked(2081, nepcal.Shrawan, 26), nepcal.DateUnchecked(2081, nepcal.Shrawan, 21), -4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, c.BusinessDaysBetween(test.from, test.to))
+		})
+	}
+
+	// Adding the business days between two dates to the first results in the
+	// second, as long as it is a business day.
+	for from := 1; from <= 32; from++ {
+		for to := 1; to <= 32; to++ {
+			start, end := nepcal.DateUnchecked(2081, nepcal.Shrawan, from), nepcal.DateUnchecked(2081, nepcal.Shrawan, to)
+			if !c.IsBusinessDay(end) {
+				continue
+			}
+
+			got, err := c.AddBusinessDays(start, c.BusinessDaysBetween(start, end))
+			assert.NoError(t, err)
+			assert.Equal(t, end, got, "from Shrawan %d to %d", from, to)
+		}
+	}
+}
+
+func TestHolidayProviders(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider HolidayProvider
+		t        nepcal.Time
+		expected bool
+	}{
+		{"dates", NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 16), nepcal.DateUnchecked(2081, nepcal.Shrawan, 20)), nepcal.DateUnchecked(2081, nepcal.Shrawan, 20), true},
+		{"not in dates", NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 16)), nepcal.DateUnchecked(2081, nepcal.Shrawan, 17), false},
+		{"dates in another year", NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 16)), nepcal.DateUnchecked(2082, nepcal.Shrawan, 16), false},
+		{"annual", Annual{{nepcal.Ashoj, 3}}, nepcal.DateUnchecked(2090, nepcal.Ashoj, 3), true},
+		{"not annual", Annual{{nepcal.Ashoj, 3}}, nepcal.DateUnchecked(2090, nepcal.Ashoj, 4), false},
+		{"func", HolidayFunc(func(t nepcal.Time) bool { return t.Day() == 1 }), nepcal.DateUnchecked(2081, nepcal.Shrawan, 1), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.provider.IsHoliday(test.t))
+		})
+	}
+}
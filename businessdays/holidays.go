@@ -0,0 +1,61 @@
+package businessdays
+
+import "github.com/srishanbhattarai/nepcal/nepcal"
+
+// HolidayProvider decides which days are holidays. Most public holidays in
+// Nepal follow the lunar calendar and are announced every year, so they are
+// usually provided as a list of Dates, while holidays such as Constitution Day
+// fall on the same B.S. date every year and can be provided as Annual dates.
+type HolidayProvider interface {
+	IsHoliday(t nepcal.Time) bool
+}
+
+// HolidayFunc adapts an ordinary function into a HolidayProvider.
+type HolidayFunc func(t nepcal.Time) bool
+
+// IsHoliday calls f(t).
+func (f HolidayFunc) IsHoliday(t nepcal.Time) bool {
+	return f(t)
+}
+
+// Dates is a HolidayProvider for a fixed set of dates.
+type Dates struct {
+	// Julian Day Numbers of the dates.
+	days map[int]bool
+}
+
+// NewDates returns a HolidayProvider for the dates.
+func NewDates(dates ...nepcal.Time) Dates {
+	d := Dates{days: make(map[int]bool, len(dates))}
+	for _, t := range dates {
+		d.days[t.JulianDay()] = true
+	}
+
+	return d
+}
+
+// IsHoliday reports if 't' is one of the dates.
+func (d Dates) IsHoliday(t nepcal.Time) bool {
+	return d.days[t.JulianDay()]
+}
+
+// MonthDay is a day of a B.S. month, without the year.
+type MonthDay struct {
+	Month nepcal.Month
+	Day   int
+}
+
+// Annual is a HolidayProvider for holidays on the same B.S. date every year,
+// e.g. Annual{{nepcal.Ashoj, 3}} for Constitution Day.
+type Annual []MonthDay
+
+// IsHoliday reports if 't' is on one of the days of the year.
+func (a Annual) IsHoliday(t nepcal.Time) bool {
+	for _, md := range a {
+		if t.Month() == md.Month && t.Day() == md.Day {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// Names of the checks.
+const (
+	adToBS    = "AD → BS"
+	bsToAD    = "BS → AD"
+	roundTrip = "round trip"
+	weekday   = "weekday"
+)
+
+// failure is an entry for which the library disagrees with the expected result.
+type failure struct {
+	// The check that failed, such as a direction of conversion.
+	check string
+
+	// The source of the expected entry.
+	source string
+
+	// The entry produced by the library, and the expected entry.
+	us, them DateMapEntry
+
+	// Details of the failure when the entries alone do not explain it.
+	reason string
+}
+
+// agreement summarises how the library agrees with a single reference source.
+type agreement struct {
+	source string
+
+	// Number of entries in the source, and the number within the supported range.
+	total, inRange int
+
+	// Number of entries checked and the disagreements in each direction.
+	checked  map[string]int
+	failures []failure
+}
+
+// Check every entry of a reference source in both directions; A.D. to B.S. using
+// nepcal.FromGregorian and B.S. to A.D. using nepcal.Date. Entries outside the
+// supported range are skipped.
+func checkSource(src source) agreement {
+	a := agreement{source: src.name, total: len(src.entries), checked: map[string]int{}}
+
+	for _, them := range src.entries {
+		checkedAD, checkedBS := false, false
+
+		if f, ok := checkADToBS(them); ok {
+			checkedAD = true
+			a.checked[adToBS]++
+
+			if f != nil {
+				f.source = src.name
+				a.failures = append(a.failures, *f)
+			}
+		}
+
+		if f, ok := checkBSToAD(them); ok {
+			checkedBS = true
+			a.checked[bsToAD]++
+
+			if f != nil {
+				f.source = src.name
+				a.failures = append(a.failures, *f)
+			}
+		}
+
+		if checkedAD || checkedBS {
+			a.inRange++
+		}
+	}
+
+	return a
+}
+
+// Check the A.D. to B.S. conversion of an entry. The boolean reports whether the
+// A.D. date is within the supported range; if it is not, the entry is not checked.
+func checkADToBS(them DateMapEntry) (*failure, bool) {
+	if _, _, _, err := nepcal.Convert(nepcal.Gregorian, nepcal.BikramSambat, them.EnYear, them.EnMonth, them.EnDay); err != nil {
+		return nil, false
+	}
+
+	bs, err := nepcal.FromGregorian(gregorian(them.EnYear, them.EnMonth, them.EnDay))
+	if err != nil {
+		return &failure{check: adToBS, them: them, reason: err.Error()}, true
+	}
+
+	us := entry(bs, bs.Gregorian())
+	if us != them {
+		return &failure{check: adToBS, us: us, them: them}, true
+	}
+
+	return nil, true
+}
+
+// Check the B.S. to A.D. conversion of an entry. The boolean reports whether the
+// B.S. year is within the supported range; if it is not, the entry is not checked.
+func checkBSToAD(them DateMapEntry) (*failure, bool) {
+	if !nepcal.IsInRangeYear(them.NpYear) {
+		return nil, false
+	}
+
+	month := nepcal.Month(them.NpMonth)
+	if month < nepcal.Baisakh || month > nepcal.Chaitra {
+		return &failure{check: bsToAD, them: them, reason: "invalid month"}, true
+	}
+
+	// Day numbers beyond the end of the month would otherwise silently overflow
+	// into the next month, hiding differences in the data tables.
+	if numDays, _ := month.NumDays(them.NpYear); them.NpDay < 1 || them.NpDay > numDays {
+		return &failure{check: bsToAD, them: them, reason: fmt.Sprintf("the month has %d days in nepcal", numDays)}, true
+	}
+
+	bs, err := nepcal.Date(them.NpYear, month, them.NpDay)
+	if err != nil {
+		return &failure{check: bsToAD, them: them, reason: err.Error()}, true
+	}
+
+	us := entry(bs, bs.Gregorian())
+	if us != them {
+		return &failure{check: bsToAD, us: us, them: them}, true
+	}
+
+	return nil, true
+}
+
+// Check that every day of the B.S. data table converts to the A.D. day that it
+// corresponds to and back to the same day, in both directions, and that the
+// weekdays of both dates are the same. Returns the number of days checked along
+// with the failures of each kind.
+func checkRoundTrips() (int, []failure) {
+	days := 0
+	var failures []failure
+
+	forEachDay(func(yy int, mm nepcal.Month, dd int, ad time.Time) {
+		days++
+
+		expected := DateMapEntry{yy, int(mm), dd, ad.Year(), int(ad.Month()), ad.Day()}
+
+		// B.S. → A.D.
+		bs, err := nepcal.Date(yy, mm, dd)
+		if err != nil {
+			failures = append(failures, failure{check: roundTrip, them: expected, reason: err.Error()})
+
+			return
+		}
+
+		if us := entry(bs, bs.Gregorian()); us != expected {
+			failures = append(failures, failure{check: roundTrip, us: us, them: expected})
+		}
+
+		// A.D. → B.S.
+		if back, err := nepcal.FromGregorian(ad); err != nil {
+			failures = append(failures, failure{check: roundTrip, them: expected, reason: err.Error()})
+		} else if us := entry(back, ad); us != expected {
+			failures = append(failures, failure{check: roundTrip, us: us, them: expected})
+		}
+
+		if bs.Weekday() != nepcal.Weekday(ad.Weekday()) {
+			failures = append(failures, failure{
+				check:  weekday,
+				them:   expected,
+				reason: fmt.Sprintf("%s in nepcal, %s in A.D.", time.Weekday(bs.Weekday()), ad.Weekday()),
+			})
+		}
+	})
+
+	return days, failures
+}
+
+// Calls 'fn' with every B.S. day of the data table in order, along with the A.D.
+// day that it corresponds to. The B.S. days are walked using the number of days
+// in each month of the table and the A.D. days are counted from the lower bound,
+// so that neither comes from the conversions being checked.
+func forEachDay(fn func(yy int, mm nepcal.Month, dd int, ad time.Time)) {
+	ad := gregorian(adLBoundY, adLBoundM, adLBoundD)
+
+	for yy := bsLBoundY; nepcal.IsInRangeYear(yy); yy++ {
+		for mm := nepcal.Baisakh; mm <= nepcal.Chaitra; mm++ {
+			// Invariant: the year is in range.
+			numDays, _ := mm.NumDays(yy)
+
+			for dd := 1; dd <= numDays; dd++ {
+				fn(yy, mm, dd, ad)
+
+				ad = ad.AddDate(0, 0, 1)
+			}
+		}
+	}
+}
+
+// entry creates the entry for a B.S. date and its A.D. equivalent.
+func entry(bs nepcal.Time, ad time.Time) DateMapEntry {
+	return DateMapEntry{
+		NpYear:  bs.Year(),
+		NpMonth: int(bs.Month()),
+		NpDay:   bs.Day(),
+		EnYear:  ad.Year(),
+		EnMonth: int(ad.Month()),
+		EnDay:   ad.Day(),
+	}
+}
+
+// gregorian creates a new time.Time in UTC with the basic yy/mm/dd parameters.
+func gregorian(yy, mm, dd int) time.Time {
+	return time.Date(yy, time.Month(mm), dd, 0, 0, 0, 0, time.UTC)
+}
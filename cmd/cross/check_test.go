@@ -0,0 +1,92 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSource(t *testing.T) {
+	dir := t.TempDir()
+
+	csvPath := filepath.Join(dir, "ref.csv")
+	assert.NoError(t, os.WriteFile(csvPath, []byte("enYear,enMonth,enDay,npYear,npMonth,npDay,note\n1994,8,21,2051,5,5,x\n"), 0o644))
+
+	jsonPath := filepath.Join(dir, "ref.json")
+	assert.NoError(t, os.WriteFile(jsonPath, []byte(`[{"npYear":2051,"npMonth":5,"npDay":5,"enYear":1994,"enMonth":8,"enDay":21}]`), 0o644))
+
+	expected := []DateMapEntry{{2051, 5, 5, 1994, 8, 21}}
+
+	for _, path := range []string{csvPath, jsonPath} {
+		src, err := loadSource(path)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, src.entries)
+	}
+
+	t.Run("missing column", func(t *testing.T) {
+		path := filepath.Join(dir, "missing.csv")
+		assert.NoError(t, os.WriteFile(path, []byte("enYear,enMonth,npYear,npMonth,npDay\n"), 0o644))
+
+		_, err := loadSource(path)
+		assert.EqualError(t, err, path+`: missing column "enDay"`)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.csv")
+		assert.NoError(t, os.WriteFile(path, []byte("enYear,enMonth,enDay,npYear,npMonth,npDay\n1994,8,x,2051,5,5\n"), 0o644))
+
+		_, err := loadSource(path)
+		assert.EqualError(t, err, path+`: row 2: invalid enDay "x"`)
+	})
+}
+
+func TestCheckSource(t *testing.T) {
+	a := checkSource(source{name: "test", entries: []DateMapEntry{
+		// Agrees in both directions.
+		{2051, 5, 5, 1994, 8, 21},
+		{1975, 1, 1, 1918, 4, 13},
+		// Out of range in both directions.
+		{1970, 1, 1, 1913, 4, 13},
+		// Shifted by a day.
+		{2053, 8, 19, 1996, 12, 3},
+		// Does not exist in nepcal, as Poush 2053 has 29 days.
+		{2053, 9, 30, 1997, 1, 14},
+	}})
+
+	assert.Equal(t, 5, a.total)
+	assert.Equal(t, 4, a.inRange)
+	assert.Equal(t, map[string]int{adToBS: 4, bsToAD: 4}, a.checked)
+
+	assert.Equal(t, []failure{
+		{check: adToBS, source: "test", us: DateMapEntry{2053, 8, 18, 1996, 12, 3}, them: DateMapEntry{2053, 8, 19, 1996, 12, 3}},
+		{check: bsToAD, source: "test", us: DateMapEntry{2053, 8, 19, 1996, 12, 4}, them: DateMapEntry{2053, 8, 19, 1996, 12, 3}},
+		{check: adToBS, source: "test", us: DateMapEntry{2053, 10, 1, 1997, 1, 14}, them: DateMapEntry{2053, 9, 30, 1997, 1, 14}},
+		{check: bsToAD, source: "test", them: DateMapEntry{2053, 9, 30, 1997, 1, 14}, reason: "the month has 29 days in nepcal"},
+	}, a.failures)
+}
+
+func TestCheckRoundTrips(t *testing.T) {
+	days, failures := checkRoundTrips()
+
+	assert.Greater(t, days, 45000)
+	assert.Empty(t, failures)
+}
+
+func TestForEachDay(t *testing.T) {
+	var first, last DateMapEntry
+	forEachDay(func(yy int, mm nepcal.Month, dd int, ad time.Time) {
+		e := DateMapEntry{yy, int(mm), dd, ad.Year(), int(ad.Month()), ad.Day()}
+		if first == (DateMapEntry{}) {
+			first = e
+		}
+
+		last = e
+	})
+
+	assert.Equal(t, DateMapEntry{1975, 1, 1, 1918, 4, 13}, first)
+	assert.Equal(t, DateMapEntry{2100, 12, 30, 2044, 4, 12}, last)
+}
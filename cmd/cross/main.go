@@ -1,14 +1,13 @@
 package main
 
 import (
-	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"strconv"
 	"time"
 
 	"github.com/fatih/color"
-	"github.com/srishanbhattarai/nepcal/nepcal"
 )
 
 // Copied from "nepcal/constants.go" as these are not public but are needed for this specific use case.
@@ -16,153 +15,84 @@ const (
 	adLBoundY = 1918
 	adLBoundM = int(time.April)
 	adLBoundD = 13
-)
 
-// DateMapEntry is each entry in the array of results returned by the reference URL.
-type DateMapEntry struct {
-	NpYear  int `json:"npYear"`
-	NpMonth int `json:"npMonth"`
-	NpDay   int `json:"npDay"`
-	EnYear  int `json:"enYear"`
-	EnMonth int `json:"enMonth"`
-	EnDay   int `json:"enDay"`
-}
+	bsLBoundY = 1975
+)
 
-// Satisfies the stringer interface.
-func (e DateMapEntry) String() string {
-	us := fmt.Sprintf("(en) %d-%d-%d", e.EnYear, e.EnMonth, e.EnDay)
-	np := fmt.Sprintf("(np) %d-%d-%d", e.NpYear, e.NpMonth, e.NpDay)
+// Exit codes, distinguishing problems with the data tables from problems
+// with running the checks, such as unreadable reference files.
+const (
+	exitOK         = 0
+	exitMismatch   = 1
+	exitInputError = 2
+)
 
-	return fmt.Sprintf("%s ==> %s", us, np)
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
 }
 
-// Get the reference entries, properly serialized, and sliced for only the range for which we support.
-func getRefEntries(refEntriesFile string) ([]DateMapEntry, error) {
-	b, err := os.ReadFile(refEntriesFile)
-	if err != nil {
-		return nil, err
+// Runs the checker with the command line arguments 'args', returning the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("cross", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: cross [--report text|json|junit] reference.json [reference.csv...]")
+		fs.PrintDefaults()
 	}
 
-	var entries []DateMapEntry
-	err = json.Unmarshal(b, &entries)
-	if err != nil {
-		return nil, err
-	}
+	format := fs.String("report", reportText, "Format of the report: text, json or junit")
 
-	// Figure out the index at which the first entry for adLBoundY exists.
-	// This is to only check for the subset of dates that *we* support rather than the date
-	// range supported by the reference JSON.
-	index := 0
-	for k, v := range entries {
-		if v.EnYear == adLBoundY && v.EnMonth == adLBoundM && v.EnDay == adLBoundD {
-			index = k
-			break
-		}
+	if err := fs.Parse(args); err != nil {
+		return exitInputError
 	}
 
-	cov := (float64(len(entries)-index+1) / float64(len(entries))) * 100
-	fmt.Printf("Coverage: %.1f\n", cov)
-
-	return entries[index:], nil
-}
-
-// Get entries for the same date range as above but from the nepcal library, as specified using 'count'.
-// Panics if date happens to be out of bounds, should never happen.
-func getNepcalEntries(count int) []DateMapEntry {
-	entries := make([]DateMapEntry, count)
-
-	t := time.Date(adLBoundY, time.Month(adLBoundM), adLBoundD, 0, 0, 0, 0, time.UTC)
-
-	i := 0
-	for i < count {
-		bs, err := nepcal.FromGregorian(t)
-		if err != nil {
-			panic(fmt.Sprintf("Invariant violation: %v, %s\n", err, t))
-		}
-
-		entry := DateMapEntry{
-			NpYear:  bs.Year(),
-			NpMonth: int(bs.Month()),
-			NpDay:   bs.Day(),
-			EnYear:  t.Year(),
-			EnMonth: int(t.Month()),
-			EnDay:   t.Day(),
-		}
+	if fs.NArg() < 1 {
+		fmt.Fprintln(stderr, "Please provide one or more reference files, either JSON or CSV.")
 
-		entries[i] = entry
-
-		t = t.AddDate(0, 0, 1)
-
-		i++
+		return exitInputError
 	}
 
-	return entries
-}
-
-// diff the two data sources and print to stdout.
-// us = entries created by this library
-// them = external entries for validation.
-func diffEntries(us, them []DateMapEntry) int {
-	if len(us) != len(them) {
-		panic(fmt.Sprintf("Invariant violation mismatching lengths; us = %v, them = %v\n", us, them))
+	var write func(io.Writer, report) error
+	switch *format {
+	case reportText:
+		write = writeText
+
+		d := color.New(color.FgCyan, color.Bold)
+		d.Fprintln(stdout, "\nNepcal correctness checker..")
+	case reportJSON:
+		write = writeJSON
+	case reportJUnit:
+		write = writeJUnit
+	default:
+		fmt.Fprintf(stderr, "Unknown report format %q. Supported formats: %s, %s, %s\n", *format, reportText, reportJSON, reportJUnit)
+
+		return exitInputError
 	}
 
-	type failure struct {
-		index int
-		us    DateMapEntry
-		them  DateMapEntry
-	}
-	var failures []failure
+	var agreements []agreement
+	for _, path := range fs.Args() {
+		src, err := loadSource(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
 
-	// Find cases where dates don't match
-	for i := 0; i < len(us); i++ {
-		if us[i] != them[i] {
-			failures = append(failures, failure{i, us[i], them[i]})
+			return exitInputError
 		}
-	}
-
-	// Pretty print diffs
-	for _, v := range failures {
-		fmt.Printf("Inconsistency: %s\n", color.BlueString(strconv.Itoa(v.index)))
 
-		us := fmt.Sprintf("- (actual)   %s", v.us)
-		them := fmt.Sprintf("+ (expected) %s", v.them)
-
-		fmt.Printf(fmt.Sprintf("%s\n", color.RedString(us)))
-		fmt.Printf(fmt.Sprintf("%s\n\n", color.GreenString(them)))
+		agreements = append(agreements, checkSource(src))
 	}
 
-	fmt.Printf(fmt.Sprintf("Number of inconsistencies: %s\n", color.YellowString(strconv.Itoa(len(failures)))))
-
-	failureRate := (float64(len(failures)) / float64(len(us))) * 100
-	fmt.Printf(fmt.Sprintf("Failure percentage: %s\n", color.YellowString(fmt.Sprintf("%.1f", failureRate))))
+	days, roundTripFailures := checkRoundTrips()
+	r := newReport(agreements, days, roundTripFailures)
 
-	return len(failures)
-}
+	if err := write(stdout, r); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
 
-func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintln(os.Stderr, "Please provide the reference JSON file.")
-		os.Exit(1)
+		return exitInputError
 	}
 
-	d := color.New(color.FgCyan, color.Bold)
-	d.Println("\nNepcal correctness checker..")
-
-	refEntries, err := getRefEntries(os.Args[1])
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if !r.ok() {
+		return exitMismatch
 	}
 
-	// length of refEntries is just a size hint for allocating memory
-	libEntries := getNepcalEntries(len(refEntries))
-
-	n := diffEntries(libEntries, refEntries)
-
-	if n == 0 {
-		os.Exit(0)
-	} else {
-		os.Exit(1)
-	}
+	return exitOK
 }
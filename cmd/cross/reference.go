@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DateMapEntry is each entry in the array of results returned by the reference URL.
+type DateMapEntry struct {
+	NpYear  int `json:"npYear"`
+	NpMonth int `json:"npMonth"`
+	NpDay   int `json:"npDay"`
+	EnYear  int `json:"enYear"`
+	EnMonth int `json:"enMonth"`
+	EnDay   int `json:"enDay"`
+}
+
+// Satisfies the stringer interface.
+func (e DateMapEntry) String() string {
+	us := fmt.Sprintf("(en) %d-%d-%d", e.EnYear, e.EnMonth, e.EnDay)
+	np := fmt.Sprintf("(np) %d-%d-%d", e.NpYear, e.NpMonth, e.NpDay)
+
+	return fmt.Sprintf("%s ==> %s", us, np)
+}
+
+// source is a set of reference entries loaded from a single file.
+type source struct {
+	name    string
+	entries []DateMapEntry
+}
+
+// Load the reference entries from a file. Files with the ".csv" extension are
+// read as CSV, and everything else as a JSON array of entries.
+func loadSource(path string) (source, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return source{}, err
+	}
+	defer f.Close()
+
+	var entries []DateMapEntry
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		entries, err = readCSVEntries(f)
+	} else {
+		err = json.NewDecoder(f).Decode(&entries)
+	}
+
+	if err != nil {
+		return source{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return source{name: path, entries: entries}, nil
+}
+
+// csvColumns are the columns required in CSV reference files, named after the
+// JSON fields of DateMapEntry.
+var csvColumns = []string{"npYear", "npMonth", "npDay", "enYear", "enMonth", "enDay"}
+
+// Read reference entries from CSV. The first row is a header naming the
+// columns in csvColumns, in any order; other columns are ignored.
+func readCSVEntries(r io.Reader) ([]DateMapEntry, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	index := map[string]int{}
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	positions := make([]int, len(csvColumns))
+	for i, name := range csvColumns {
+		pos, ok := index[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+
+		positions[i] = pos
+	}
+
+	var entries []DateMapEntry
+	for row := 2; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		var values [6]int
+		for i, pos := range positions {
+			if values[i], err = strconv.Atoi(strings.TrimSpace(record[pos])); err != nil {
+				return nil, fmt.Errorf("row %d: invalid %s %q", row, csvColumns[i], record[pos])
+			}
+		}
+
+		entries = append(entries, DateMapEntry{values[0], values[1], values[2], values[3], values[4], values[5]})
+	}
+}
@@ -0,0 +1,427 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// Report formats.
+const (
+	reportText  = "text"
+	reportJSON  = "json"
+	reportJUnit = "junit"
+)
+
+// report is the result of all the checks.
+type report struct {
+	Sources    []sourceReport `json:"sources"`
+	RoundTrips roundTripCheck `json:"roundTrips"`
+	Mismatches []mismatch     `json:"mismatches"`
+
+	// All the failures, in the order they were found.
+	failures []failure
+}
+
+// sourceReport is the agreement of the library with a single reference source.
+type sourceReport struct {
+	Name    string          `json:"name"`
+	Entries int             `json:"entries"`
+	InRange int             `json:"inRange"`
+	Checks  []checkedReport `json:"checks"`
+}
+
+// checkedReport is the number of entries checked, and those that failed, for a single check.
+type checkedReport struct {
+	Check    string `json:"check"`
+	Checked  int    `json:"checked"`
+	Failures int    `json:"failures"`
+}
+
+// roundTripCheck is the result of the round trip and weekday checks.
+type roundTripCheck struct {
+	Days     int `json:"days"`
+	Failures int `json:"failures"`
+}
+
+// mismatch is a contiguous range of days that failed the same check in the same way.
+type mismatch struct {
+	Check  string `json:"check"`
+	Source string `json:"source,omitempty"`
+
+	// Human readable description, e.g. "BS 2089 Ashar shifted by 1 day".
+	Summary string `json:"summary"`
+
+	// The B.S. year and month of the first day, which identify the row of the
+	// data table that is likely to be wrong.
+	BSYear  int `json:"bsYear"`
+	BSMonth int `json:"bsMonth"`
+
+	// The expected first and last days of the range.
+	First DateMapEntry `json:"first"`
+	Last  DateMapEntry `json:"last"`
+	Days  int          `json:"days"`
+
+	// Number of days the library's A.D. date is ahead of the expected A.D.
+	// date for the same B.S. date, if it could be determined.
+	Shift *int `json:"shift,omitempty"`
+
+	Reason string `json:"reason,omitempty"`
+
+	Entries []mismatchEntry `json:"entries"`
+}
+
+// mismatchEntry is a single day within a mismatch.
+type mismatchEntry struct {
+	Expected DateMapEntry `json:"expected"`
+	Actual   DateMapEntry `json:"actual"`
+}
+
+// newReport builds the report from the agreements with each source and the
+// results of the round trip checks.
+func newReport(agreements []agreement, days int, roundTripFailures []failure) report {
+	r := report{RoundTrips: roundTripCheck{Days: days, Failures: len(roundTripFailures)}}
+
+	for _, a := range agreements {
+		sr := sourceReport{Name: a.source, Entries: a.total, InRange: a.inRange}
+
+		for _, check := range []string{adToBS, bsToAD} {
+			n := 0
+			for _, f := range a.failures {
+				if f.check == check {
+					n++
+				}
+			}
+
+			sr.Checks = append(sr.Checks, checkedReport{Check: check, Checked: a.checked[check], Failures: n})
+		}
+
+		r.Sources = append(r.Sources, sr)
+		r.failures = append(r.failures, a.failures...)
+	}
+
+	r.failures = append(r.failures, roundTripFailures...)
+	r.Mismatches = groupFailures(r.failures)
+
+	return r
+}
+
+// ok reports if all the checks passed.
+func (r report) ok() bool {
+	return len(r.failures) == 0
+}
+
+// total returns the number of checks made.
+func (r report) total() int {
+	n := r.RoundTrips.Days
+	for _, s := range r.Sources {
+		for _, c := range s.Checks {
+			n += c.Checked
+		}
+	}
+
+	return n
+}
+
+// groupFailures groups failures of the same check and source into contiguous
+// ranges of days that are off by the same shift, or fail for the same reason.
+func groupFailures(failures []failure) []mismatch {
+	sorted := make([]failure, len(failures))
+	copy(sorted, failures)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.source != b.source {
+			return a.source < b.source
+		}
+
+		if a.check != b.check {
+			return a.check < b.check
+		}
+
+		return enDate(a.them).Before(enDate(b.them))
+	})
+
+	var mismatches []mismatch
+	for _, f := range sorted {
+		shift := failureShift(f)
+
+		if n := len(mismatches); n > 0 {
+			m := &mismatches[n-1]
+
+			contiguous := enDate(f.them).Equal(enDate(m.Last).AddDate(0, 0, 1))
+			if m.Check == f.check && m.Source == f.source && m.Reason == f.reason && sameShift(m.Shift, shift) && contiguous {
+				m.Last = f.them
+				m.Days++
+				m.Entries = append(m.Entries, mismatchEntry{f.them, f.us})
+
+				continue
+			}
+		}
+
+		mismatches = append(mismatches, mismatch{
+			Check:   f.check,
+			Source:  f.source,
+			BSYear:  f.them.NpYear,
+			BSMonth: f.them.NpMonth,
+			First:   f.them,
+			Last:    f.them,
+			Days:    1,
+			Shift:   shift,
+			Reason:  f.reason,
+			Entries: []mismatchEntry{{f.them, f.us}},
+		})
+	}
+
+	for i := range mismatches {
+		mismatches[i].Summary = mismatches[i].summary()
+	}
+
+	return mismatches
+}
+
+// failureShift returns the number of days that the A.D. date of the expected
+// B.S. date, as converted by the library, is ahead of the expected A.D. date.
+// Returns nil if the expected B.S. date does not exist in the library.
+func failureShift(f failure) *int {
+	y, m, d, err := nepcal.Convert(nepcal.BikramSambat, nepcal.Gregorian, f.them.NpYear, f.them.NpMonth, f.them.NpDay)
+	if err != nil {
+		return nil
+	}
+
+	shift := int(gregorian(y, m, d).Sub(enDate(f.them)).Hours() / 24)
+
+	return &shift
+}
+
+// sameShift reports if two shifts are equal, or both unknown.
+func sameShift(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// summary describes the mismatch, e.g. "BS 2089 Ashar shifted by 1 day".
+func (m mismatch) summary() string {
+	desc := m.Reason
+	if m.Shift != nil && *m.Shift != 0 {
+		unit := "days"
+		if *m.Shift == 1 || *m.Shift == -1 {
+			unit = "day"
+		}
+
+		desc = fmt.Sprintf("shifted by %d %s", *m.Shift, unit)
+	}
+
+	if desc == "" {
+		desc = "mismatch"
+	}
+
+	return fmt.Sprintf("%s: BS %s %s", m.Check, bsRange(m.First, m.Last), desc)
+}
+
+// bsRange describes the range of B.S. dates from 'first' to 'last', such as
+// "2089 Ashar" when the range is a whole month.
+func bsRange(first, last DateMapEntry) string {
+	month := func(e DateMapEntry) string {
+		if name, err := nepcal.Month(e.NpMonth).MarshalText(); err == nil {
+			return string(name)
+		}
+
+		return strconv.Itoa(e.NpMonth)
+	}
+
+	if first == last {
+		return fmt.Sprintf("%d %s %d", first.NpYear, month(first), first.NpDay)
+	}
+
+	sameMonth := first.NpYear == last.NpYear && first.NpMonth == last.NpMonth
+	if numDays, err := nepcal.Month(first.NpMonth).NumDays(first.NpYear); sameMonth && err == nil && first.NpDay == 1 && last.NpDay >= numDays {
+		return fmt.Sprintf("%d %s", first.NpYear, month(first))
+	}
+
+	return fmt.Sprintf("%d %s %d to %d %s %d", first.NpYear, month(first), first.NpDay, last.NpYear, month(last), last.NpDay)
+}
+
+// enDate returns the A.D. date of an entry.
+func enDate(e DateMapEntry) time.Time {
+	return gregorian(e.EnYear, e.EnMonth, e.EnDay)
+}
+
+// Write the report as JSON.
+func writeJSON(w io.Writer, r report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(struct {
+		OK bool `json:"ok"`
+		report
+	}{r.ok(), r})
+}
+
+// JUnit XML elements, as understood by most CI systems.
+type (
+	junitSuites struct {
+		XMLName xml.Name     `xml:"testsuites"`
+		Suites  []junitSuite `xml:"testsuite"`
+	}
+
+	junitSuite struct {
+		Name     string      `xml:"name,attr"`
+		Tests    int         `xml:"tests,attr"`
+		Failures int         `xml:"failures,attr"`
+		Cases    []junitCase `xml:"testcase"`
+	}
+
+	junitCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Details string `xml:",chardata"`
+	}
+)
+
+// Write the report as JUnit XML. Each source and the round trip checks are a
+// test suite, in which every check is a passing test case unless it has
+// mismatches, in which case every mismatch is a failing test case.
+func writeJUnit(w io.Writer, r report) error {
+	var suites junitSuites
+
+	// Round trip mismatches have no source.
+	suite := func(name, source string, checks []string) {
+		s := junitSuite{Name: name}
+
+		for _, check := range checks {
+			found := false
+
+			for _, m := range r.Mismatches {
+				if m.Check != check || m.Source != source {
+					continue
+				}
+
+				found = true
+				s.Failures++
+				s.Cases = append(s.Cases, junitCase{
+					Name:      m.Summary,
+					ClassName: check,
+					Failure:   &junitFailure{Message: m.Summary, Details: mismatchDetails(m)},
+				})
+			}
+
+			if !found {
+				s.Cases = append(s.Cases, junitCase{Name: check, ClassName: check})
+			}
+		}
+
+		s.Tests = len(s.Cases)
+		suites.Suites = append(suites.Suites, s)
+	}
+
+	for _, s := range r.Sources {
+		suite(s.Name, s.Name, []string{adToBS, bsToAD})
+	}
+	suite("round trips", "", []string{roundTrip, weekday})
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// mismatchDetails lists every day of a mismatch.
+func mismatchDetails(m mismatch) string {
+	var b strings.Builder
+	for _, e := range m.Entries {
+		fmt.Fprintf(&b, "- (actual)   %s\n+ (expected) %s\n", e.Actual, e.Expected)
+	}
+
+	return b.String()
+}
+
+// Write the report as coloured text, listing every failure followed by the
+// mismatched ranges.
+func writeText(w io.Writer, r report) error {
+	for _, s := range r.Sources {
+		fmt.Fprintf(w, "Source: %s\n", color.BlueString(s.Name))
+
+		cov := 0.0
+		if s.Entries > 0 {
+			cov = (float64(s.InRange) / float64(s.Entries)) * 100
+		}
+		fmt.Fprintf(w, "Coverage: %d of %d entries in range (%.1f)\n", s.InRange, s.Entries, cov)
+
+		for _, c := range s.Checks {
+			fmt.Fprintf(w, "%s: %d agree, %s disagree\n", c.Check, c.Checked-c.Failures, color.YellowString(strconv.Itoa(c.Failures)))
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "Round trips and weekdays: %d days checked, %s failures\n\n", r.RoundTrips.Days, color.YellowString(strconv.Itoa(r.RoundTrips.Failures)))
+
+	// Pretty print diffs
+	for i, v := range r.failures {
+		fmt.Fprintf(w, "Inconsistency (%s): %s\n", v.check, color.BlueString(strconv.Itoa(i)))
+
+		if v.source != "" {
+			fmt.Fprintf(w, "  source: %s\n", v.source)
+		}
+
+		if v.reason != "" {
+			fmt.Fprintf(w, "  %s\n", v.reason)
+		}
+
+		fmt.Fprintln(w, color.RedString("- (actual)   %s", v.us))
+		fmt.Fprintf(w, "%s\n\n", color.GreenString("+ (expected) %s", v.them))
+	}
+
+	if len(r.Mismatches) > 0 {
+		fmt.Fprintln(w, "Mismatched ranges:")
+
+		for _, m := range r.Mismatches {
+			days := "1 day"
+			if m.Days != 1 {
+				days = fmt.Sprintf("%d days", m.Days)
+			}
+
+			fmt.Fprintf(w, "  %s (%s from AD %s)\n", color.RedString(m.Summary), days, enDate(m.First).Format("2006-01-02"))
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "Number of inconsistencies: %s\n", color.YellowString(strconv.Itoa(len(r.failures))))
+
+	failureRate := 0.0
+	if total := r.total(); total > 0 {
+		failureRate = (float64(len(r.failures)) / float64(total)) * 100
+	}
+
+	_, err := fmt.Fprintf(w, "Failure percentage: %s\n", color.YellowString("%.1f", failureRate))
+
+	return err
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupFailures(t *testing.T) {
+	a := checkSource(source{name: "test", entries: []DateMapEntry{
+		// Shifted by a day, out of order.
+		{2081, 4, 3, 2024, 7, 17},
+		{2081, 4, 2, 2024, 7, 16},
+		{2081, 4, 4, 2024, 7, 18},
+		// Does not exist in nepcal, as Poush 2053 has 29 days.
+		{2053, 9, 30, 1997, 1, 14},
+		// Agrees in both directions.
+		{2051, 5, 5, 1994, 8, 21},
+	}})
+
+	mismatches := groupFailures(a.failures)
+
+	summaries := []string{}
+	for _, m := range mismatches {
+		summaries = append(summaries, m.Summary)
+	}
+
+	assert.Equal(t, []string{
+		"AD → BS: BS 2053 Poush 30 mismatch",
+		"AD → BS: BS 2081 Shrawan 2 to 2081 Shrawan 4 shifted by 1 day",
+		"BS → AD: BS 2053 Poush 30 the month has 29 days in nepcal",
+		"BS → AD: BS 2081 Shrawan 2 to 2081 Shrawan 4 shifted by 1 day",
+	}, summaries)
+
+	assert.Equal(t, 3, mismatches[1].Days)
+	assert.Equal(t, DateMapEntry{2081, 4, 2, 2024, 7, 16}, mismatches[1].First)
+	assert.Equal(t, DateMapEntry{2081, 4, 4, 2024, 7, 18}, mismatches[1].Last)
+	assert.Equal(t, 2081, mismatches[1].BSYear)
+	assert.Equal(t, 4, mismatches[1].BSMonth)
+	assert.Equal(t, 1, *mismatches[1].Shift)
+	assert.Nil(t, mismatches[2].Shift)
+}
+
+func TestBSRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		first, last DateMapEntry
+		expected    string
+	}{
+		{"single day", DateMapEntry{2089, 3, 5, 0, 0, 0}, DateMapEntry{2089, 3, 5, 0, 0, 0}, "2089 Ashar 5"},
+		{"whole month", DateMapEntry{2081, 4, 1, 0, 0, 0}, DateMapEntry{2081, 4, 32, 0, 0, 0}, "2081 Shrawan"},
+		{"part of a month", DateMapEntry{2081, 4, 2, 0, 0, 0}, DateMapEntry{2081, 4, 32, 0, 0, 0}, "2081 Shrawan 2 to 2081 Shrawan 32"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, bsRange(test.first, test.last))
+		})
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	a := checkSource(source{name: "test", entries: []DateMapEntry{
+		{2051, 5, 5, 1994, 8, 21},
+		{2053, 8, 19, 1996, 12, 3},
+	}})
+	r := newReport([]agreement{a}, 10, nil)
+
+	assert.False(t, r.ok())
+	assert.Equal(t, 14, r.total())
+
+	t.Run("json", func(t *testing.T) {
+		var b bytes.Buffer
+		assert.NoError(t, writeJSON(&b, r))
+
+		var decoded struct {
+			OK         bool           `json:"ok"`
+			Sources    []sourceReport `json:"sources"`
+			RoundTrips roundTripCheck `json:"roundTrips"`
+			Mismatches []mismatch     `json:"mismatches"`
+		}
+		assert.NoError(t, json.Unmarshal(b.Bytes(), &decoded))
+
+		assert.False(t, decoded.OK)
+		assert.Equal(t, r.Sources, decoded.Sources)
+		assert.Equal(t, roundTripCheck{Days: 10}, decoded.RoundTrips)
+		assert.Len(t, decoded.Mismatches, 2)
+		assert.Equal(t, "AD → BS: BS 2053 Mangshir 19 shifted by 1 day", decoded.Mismatches[0].Summary)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		var b bytes.Buffer
+		assert.NoError(t, writeJUnit(&b, r))
+
+		var decoded junitSuites
+		assert.NoError(t, xml.Unmarshal(b.Bytes(), &decoded))
+
+		assert.Len(t, decoded.Suites, 2)
+		assert.Equal(t, "test", decoded.Suites[0].Name)
+		assert.Equal(t, 2, decoded.Suites[0].Failures)
+		assert.Equal(t, "round trips", decoded.Suites[1].Name)
+		assert.Equal(t, 0, decoded.Suites[1].Failures)
+		assert.Equal(t, 2, decoded.Suites[1].Tests)
+	})
+}
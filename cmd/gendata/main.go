@@ -0,0 +1,94 @@
+// Command gendata regenerates the B.S. data tables in "nepcal/constants.go"
+// from reference fixture files, such as the one used by cmd/cross. It is run
+// through 'go generate ./nepcal' and prints a summary of the months that
+// changed compared to the current tables.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// The first B.S. year of the tables. Changing it also changes the lower bounds.
+const defaultFirstYear = 1975
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "gendata: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Runs the generator with the command line arguments 'args'.
+func run(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("gendata", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: gendata [-o constants.go] [-from year] reference.json [other.json...]")
+		fs.PrintDefaults()
+	}
+
+	out := fs.String("o", "constants.go", "Path of the generated file")
+	first := fs.Int("from", defaultFirstYear, "First B.S. year of the tables")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() < 1 {
+		fs.Usage()
+
+		return fmt.Errorf("no fixture files provided")
+	}
+
+	var entries []DateMapEntry
+	for _, path := range fs.Args() {
+		e, err := readEntries(path)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, e...)
+	}
+
+	t, err := buildTable(entries, *first)
+	if err != nil {
+		return err
+	}
+
+	// The package refuses to load an inconsistent table, so never generate one.
+	if err := t.dataTable().Validate(); err != nil {
+		return err
+	}
+
+	src, err := render(t)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Generated %s for BS %d to %d (AD %s to %s).\n", *out, t.first, t.last(), t.adLow.Format(isoDate), t.adHigh.Format(isoDate))
+
+	if t.unconfirmed {
+		fmt.Fprintf(stdout, "The fixtures end on BS %s; the length of the last month could not be confirmed.\n", t.upper())
+	}
+
+	changes := diff(t)
+	if len(changes) == 0 {
+		fmt.Fprintln(stdout, "No changes to the data tables.")
+
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "%d changes to the data tables:\n", len(changes))
+	for _, line := range changes {
+		fmt.Fprintf(stdout, "  %s\n", line)
+	}
+
+	return nil
+}
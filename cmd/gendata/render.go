@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+const isoDate = "2006-01-02"
+
+// source is the template of "nepcal/constants.go".
+var source = template.Must(template.New("constants.go").Parse(`// Code generated by cmd/gendata from reference fixtures. DO NOT EDIT.
+
+//go:generate go run ../cmd/gendata -o constants.go ../cmd/cross/reference.json
+
+package nepcal
+
+import "time"
+
+// Lower and Upper bounds for AD and BS years along with diffs for
+// month and days.
+// Invariant: the AD and BS lower bounds are the effective same date.
+const (
+	adLBoundY = {{.ADLow.Year}}
+	adLBoundM = int(time.{{.ADLow.Month}})
+	adLBoundD = {{.ADLow.Day}}
+
+	bsLBoundY = {{.First}}
+	bsLBoundM = 1
+	bsLBoundD = 1
+
+	// The upper bounds are the last day of the last year that the fixtures cover.
+	bsUBoundY = {{.Upper.Year}}
+	bsUBoundM = {{.Upper.Month}}
+	bsUBoundD = {{.Upper.Day}}
+
+	adUBoundY = {{.ADHigh.Year}}
+	adUBoundM = int(time.{{.ADHigh.Month}})
+	adUBoundD = {{.ADHigh.Day}}
+)
+
+// bsDaysInMonthsByYear is a map of each BS year from BSLBound to BSUBound with a slice
+// of 12 ints indicating the number of days in each month.
+var bsDaysInMonthsByYear = map[int][]int{
+{{- range .Rows}}
+	{{.Key}}: { {{- .Days -}} },
+{{- end}}
+}
+`))
+
+// render generates the source of "nepcal/constants.go" for the table.
+func render(t table) ([]byte, error) {
+	type row struct {
+		Key, Days string
+	}
+
+	rows := make([]row, len(t.years))
+	for i, days := range t.years {
+		key := strconv.Itoa(t.first + i)
+		switch i {
+		case 0:
+			key = "bsLBoundY"
+		case len(t.years) - 1:
+			key = "bsUBoundY"
+		}
+
+		values := make([]string, len(days))
+		for j, n := range days {
+			values[j] = strconv.Itoa(n)
+		}
+
+		rows[i] = row{key, strings.Join(values, ", ")}
+	}
+
+	type date struct {
+		Year, Month, Day int
+	}
+
+	upper := t.upper()
+
+	var b bytes.Buffer
+	err := source.Execute(&b, struct {
+		First         int
+		ADLow, ADHigh time.Time
+		Upper         date
+		Rows          []row
+	}{t.first, t.adLow, t.adHigh, date{upper.year, upper.month, upper.day}, rows})
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// diff describes how the table differs from the one compiled into the nepcal
+// package, one line per changed month or added or removed year.
+func diff(t table) []string {
+	var lines []string
+
+	if low, err := nepcal.Date(t.first, nepcal.Baisakh, 1); err == nil && !low.Gregorian().Equal(t.adLow) {
+		lines = append(lines, fmt.Sprintf("BS %s: AD %s → AD %s", bsDate{t.first, 1, 1}, low.Gregorian().Format(isoDate), t.adLow.Format(isoDate)))
+	}
+
+	before, after := nepcal.BuiltinDataTable().DaysInMonths, t.dataTable().DaysInMonths
+
+	var years []int
+	for y := range before {
+		years = append(years, y)
+	}
+
+	for y := range after {
+		if _, ok := before[y]; !ok {
+			years = append(years, y)
+		}
+	}
+
+	sort.Ints(years)
+
+	for _, y := range years {
+		old, ok := before[y]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("BS %d: added", y))
+
+			continue
+		}
+
+		days, ok := after[y]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("BS %d: removed", y))
+
+			continue
+		}
+
+		for j, n := range days {
+			if old[j] != n {
+				name, _ := nepcal.Month(j + 1).MarshalText()
+				lines = append(lines, fmt.Sprintf("BS %d %s: %d → %d days", y, name, old[j], n))
+			}
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+// currentTable returns the table compiled into the nepcal package.
+func currentTable() table {
+	t := table{first: 1975, adLow: gregorian(1918, 4, 13)}
+
+	for y := t.first; nepcal.IsInRangeYear(y); y++ {
+		var months [12]int
+		for m := nepcal.Baisakh; m <= nepcal.Chaitra; m++ {
+			months[m-1], _ = m.NumDays(y)
+		}
+
+		t.years = append(t.years, months)
+	}
+
+	upper := t.upper()
+	t.adHigh = nepcal.DateUnchecked(upper.year, nepcal.Month(upper.month), upper.day).Gregorian()
+
+	return t
+}
+
+func TestRender(t *testing.T) {
+	src, err := render(currentTable())
+	assert.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "constants.go", src, parser.AllErrors)
+	assert.NoError(t, err)
+
+	for _, line := range []string{
+		"// Code generated by cmd/gendata from reference fixtures. DO NOT EDIT.\n",
+		"\tadLBoundY = 1918\n\tadLBoundM = int(time.April)\n\tadLBoundD = 13\n",
+		"\tbsUBoundY = 2100\n\tbsUBoundM = 12\n\tbsUBoundD = 30\n",
+		"\tadUBoundY = 2044\n\tadUBoundM = int(time.April)\n\tadUBoundD = 12\n",
+		"\tbsLBoundY: {31, 31, 32, 32, 31, 30, 30, 29, 30, 29, 30, 30},\n",
+		"\t2081:      {31, 32, 31, 32, 31, 30, 30, 30, 29, 30, 29, 31},\n",
+		"\tbsUBoundY: {31, 32, 31, 32, 30, 31, 30, 29, 30, 29, 30, 30},\n",
+	} {
+		assert.Contains(t, string(src), line)
+	}
+
+	// Only the generated data belongs in the template.
+	assert.NotContains(t, string(src), "unixEpochJDN")
+}
+
+// The committed tables are exactly what gendata generates for them.
+func TestRenderReproducesConstants(t *testing.T) {
+	table := currentTable()
+	assert.NoError(t, table.dataTable().Validate())
+
+	src, err := render(table)
+	assert.NoError(t, err)
+
+	committed, err := os.ReadFile("../../nepcal/constants.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(committed), string(src))
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(*table)
+		expected []string
+	}{
+		{"unchanged", func(*table) {}, nil},
+		{
+			"changed months",
+			func(t *table) {
+				t.years[2081-1975][3] = 31
+				t.years[2081-1975][4] = 32
+			},
+			[]string{"BS 2081 Shrawan: 32 → 31 days", "BS 2081 Bhadra: 31 → 32 days"},
+		},
+		{
+			"added and removed years",
+			func(t *table) {
+				t.years = append(t.years[1:], t.years[0])
+				t.first++
+				t.adLow = gregorian(1919, 4, 14)
+			},
+			[]string{"BS 1976-01-01: AD 1919-04-13 → AD 1919-04-14", "BS 1975: removed", "BS 2101: added"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := currentTable()
+			test.change(&table)
+
+			assert.Equal(t, test.expected, diff(table))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// DateMapEntry is each entry in the array of a reference fixture file.
+// Copied from "cmd/cross" as the fixtures are shared between the two tools.
+type DateMapEntry struct {
+	NpYear  int `json:"npYear"`
+	NpMonth int `json:"npMonth"`
+	NpDay   int `json:"npDay"`
+	EnYear  int `json:"enYear"`
+	EnMonth int `json:"enMonth"`
+	EnDay   int `json:"enDay"`
+}
+
+// Read the entries of a reference fixture file, a JSON array of DateMapEntry.
+func readEntries(path string) ([]DateMapEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []DateMapEntry
+	if err := json.NewDecoder(f).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// bsDate is a B.S. date as it appears in the fixtures.
+type bsDate struct {
+	year, month, day int
+}
+
+// Satisfies the stringer interface.
+func (d bsDate) String() string {
+	return fmt.Sprintf("%d-%02d-%02d", d.year, d.month, d.day)
+}
+
+// table is the data derived from the fixtures: the number of days in every
+// month of the B.S. years from 'first', and the A.D. dates of the bounds.
+type table struct {
+	first  int
+	years  [][12]int
+	adLow  time.Time
+	adHigh time.Time
+
+	// Set if the fixtures end on the last day of the table, in which case the
+	// length of the last month could not be confirmed by the month after it.
+	unconfirmed bool
+}
+
+// last returns the last B.S. year in the table.
+func (t table) last() int {
+	return t.first + len(t.years) - 1
+}
+
+// upper returns the last B.S. date in the table.
+func (t table) upper() bsDate {
+	return bsDate{t.last(), 12, t.years[len(t.years)-1][11]}
+}
+
+// dataTable returns the table in the form validated by the nepcal package.
+func (t table) dataTable() nepcal.DataTable {
+	days := map[int][]int{}
+	for i, months := range t.years {
+		days[t.first+i] = append([]int{}, months[:]...)
+	}
+
+	upper := t.upper()
+
+	return nepcal.DataTable{
+		DaysInMonths: days,
+		BSLower:      [3]int{t.first, 1, 1},
+		BSUpper:      [3]int{upper.year, upper.month, upper.day},
+		ADLower:      t.adLow,
+		ADUpper:      t.adHigh,
+	}
+}
+
+// Derive the table from the fixture entries, starting at the first day of the
+// B.S. year 'first'. Days are followed one at a time, and the length of a month
+// is known once the first day of the next month is found on the next A.D. day.
+// The table ends at the last whole year in the fixtures; if the fixtures end
+// within Chaitra, the month is assumed to end with them. Entries for the same
+// B.S. date that disagree are only an error if the date is within the table,
+// as some fixtures use placeholders for dates they do not support.
+func buildTable(entries []DateMapEntry, first int) (table, error) {
+	index := map[bsDate][]time.Time{}
+	for _, e := range entries {
+		d := bsDate{e.NpYear, e.NpMonth, e.NpDay}
+		ad := gregorian(e.EnYear, e.EnMonth, e.EnDay)
+
+		known := false
+		for _, other := range index[d] {
+			known = known || other.Equal(ad)
+		}
+
+		if !known {
+			index[d] = append(index[d], ad)
+		}
+	}
+
+	lookup := func(d bsDate) (time.Time, bool, error) {
+		switch ads := index[d]; len(ads) {
+		case 0:
+			return time.Time{}, false, nil
+		case 1:
+			return ads[0], true, nil
+		default:
+			return time.Time{}, false, fmt.Errorf("BS %s has conflicting entries: AD %s and AD %s", d, ads[0].Format(isoDate), ads[1].Format(isoDate))
+		}
+	}
+
+	d := bsDate{first, 1, 1}
+	ad, ok, err := lookup(d)
+	if err != nil {
+		return table{}, err
+	}
+
+	if !ok {
+		return table{}, fmt.Errorf("no entry for BS %s, the start of the table", d)
+	}
+
+	t := table{first: first, adLow: ad}
+	var year [12]int
+
+	for {
+		next := ad.AddDate(0, 0, 1)
+
+		// Either the next day of the same month, or the first day of the next month.
+		candidates := []bsDate{{d.year, d.month, d.day + 1}, {d.year, d.month + 1, 1}}
+		if d.month == 12 {
+			candidates[1] = bsDate{d.year + 1, 1, 1}
+		}
+
+		found := false
+		for _, c := range candidates {
+			cad, ok, err := lookup(c)
+			if err != nil {
+				return table{}, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			if !cad.Equal(next) {
+				return table{}, fmt.Errorf("BS %s is AD %s, but follows BS %s which is AD %s", c, cad.Format(isoDate), d, ad.Format(isoDate))
+			}
+
+			if c.day == 1 {
+				if d.day < 29 || d.day > 32 {
+					return table{}, fmt.Errorf("BS %d-%02d has %d days", d.year, d.month, d.day)
+				}
+
+				year[d.month-1] = d.day
+				if d.month == 12 {
+					t.years = append(t.years, year)
+					t.adHigh = ad
+					year = [12]int{}
+				}
+			}
+
+			d, ad, found = c, next, true
+
+			break
+		}
+
+		if !found {
+			if d.month == 12 && d.day >= 29 && d.day <= 32 {
+				year[11] = d.day
+				t.years = append(t.years, year)
+				t.adHigh = ad
+				t.unconfirmed = true
+			}
+
+			break
+		}
+	}
+
+	if len(t.years) == 0 {
+		return table{}, fmt.Errorf("the fixtures do not cover a whole year from BS %s", bsDate{first, 1, 1})
+	}
+
+	return t, nil
+}
+
+// gregorian creates a new time.Time in UTC with the basic yy/mm/dd parameters.
+func gregorian(yy, mm, dd int) time.Time {
+	return time.Date(yy, time.Month(mm), dd, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixture creates the entries for every day of the B.S. years from 'first',
+// with the months in 'years', starting on 'start'.
+func fixture(first int, start time.Time, years [][12]int) []DateMapEntry {
+	var entries []DateMapEntry
+
+	ad := start
+	for i, months := range years {
+		for m, days := range months {
+			for d := 1; d <= days; d++ {
+				entries = append(entries, DateMapEntry{first + i, m + 1, d, ad.Year(), int(ad.Month()), ad.Day()})
+				ad = ad.AddDate(0, 0, 1)
+			}
+		}
+	}
+
+	return entries
+}
+
+func TestBuildTable(t *testing.T) {
+	start := gregorian(1918, 4, 13)
+	years := [][12]int{
+		{31, 31, 32, 32, 31, 30, 30, 29, 30, 29, 30, 30},
+		{31, 32, 31, 32, 31, 30, 30, 30, 29, 29, 30, 31},
+	}
+
+	t.Run("confirmed by the next year", func(t *testing.T) {
+		entries := fixture(1975, start, years)
+
+		// The first day of the next year, followed by a placeholder.
+		entries = append(entries, DateMapEntry{1977, 1, 1, 1920, 4, 13}, DateMapEntry{1900, 1, 1, 1920, 4, 14})
+
+		table, err := buildTable(entries, 1975)
+		assert.NoError(t, err)
+		assert.Equal(t, years, table.years)
+		assert.Equal(t, 1976, table.last())
+		assert.Equal(t, start, table.adLow)
+		assert.Equal(t, gregorian(1920, 4, 12), table.adHigh)
+		assert.False(t, table.unconfirmed)
+		assert.NoError(t, table.dataTable().Validate())
+	})
+
+	t.Run("fixtures end with the table", func(t *testing.T) {
+		table, err := buildTable(fixture(1975, start, years), 1975)
+		assert.NoError(t, err)
+		assert.Equal(t, years, table.years)
+		assert.True(t, table.unconfirmed)
+		assert.Equal(t, bsDate{1976, 12, 31}, table.upper())
+	})
+
+	t.Run("incomplete year", func(t *testing.T) {
+		entries := fixture(1975, start, years)
+
+		table, err := buildTable(entries[:len(entries)-40], 1975)
+		assert.NoError(t, err)
+		assert.Equal(t, years[:1], table.years)
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		entries := fixture(1975, start, years)
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+
+		table, err := buildTable(entries, 1975)
+		assert.NoError(t, err)
+		assert.Equal(t, years, table.years)
+	})
+
+	t.Run("missing start", func(t *testing.T) {
+		_, err := buildTable(fixture(1975, start, years), 1974)
+		assert.EqualError(t, err, "no entry for BS 1974-01-01, the start of the table")
+	})
+
+	t.Run("conflicting entries", func(t *testing.T) {
+		entries := append(fixture(1975, start, years), DateMapEntry{1975, 1, 5, 1918, 4, 18})
+
+		_, err := buildTable(entries, 1975)
+		assert.EqualError(t, err, "BS 1975-01-05 has conflicting entries: AD 1918-04-17 and AD 1918-04-18")
+	})
+
+	t.Run("skipped day", func(t *testing.T) {
+		entries := fixture(1975, start, years)
+		entries[10].EnDay++
+
+		_, err := buildTable(entries, 1975)
+		assert.EqualError(t, err, "BS 1975-01-11 is AD 1918-04-24, but follows BS 1975-01-10 which is AD 1918-04-22")
+	})
+
+	t.Run("inconsistent table", func(t *testing.T) {
+		invalid := append([][12]int{}, years...)
+		invalid[1][0], invalid[1][1] = 29, 29
+
+		table, err := buildTable(fixture(1975, start, invalid), 1975)
+		assert.NoError(t, err)
+		assert.EqualError(t, table.dataTable().Validate(), "Invalid B.S. data table: 1976 has 361 days")
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		invalid := append([][12]int{}, years...)
+		invalid[0][1] = 28
+
+		_, err := buildTable(fixture(1975, start, invalid), 1975)
+		assert.EqualError(t, err, "BS 1975-02 has 28 days")
+	})
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+)
+
+// errFutureBirth is the error returned when the date of birth is after the date
+// that the age is calculated on.
+var errFutureBirth = errors.New("The date of birth is after the date to calculate the age on")
+
+// Flags for the 'age' command.
+func ageFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "from",
+			Usage: "Calendar system of the dates (" + strings.Join(nepcal.CalendarSystemNames(), ", ") + ")",
+			Value: "bs",
+		},
+		&cli.StringFlag{
+			Name:  "on",
+			Usage: "Date to calculate the age on, in the same format as the date of birth, instead of today",
+		},
+	}
+}
+
+// Shows the age of someone born on the date in the arguments, in B.S. years,
+// months and days.
+func (nepcalCli) showAge(c *cli.Context) error {
+	cs, ok := nepcal.LookupCalendarSystem(c.String("from"))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown calendar system %q. Supported systems: %s\n", c.String("from"), strings.Join(nepcal.CalendarSystemNames(), ", "))
+
+		return cli.Exit("", 1)
+	}
+
+	now := time.Now()
+
+	dob, err := parseDateArg(strings.Join(c.Args().Slice(), " "), cs, now)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Please supply a valid date of birth in the format mm-dd-yyyy, or a B.S. date such as `15 Shrawan 2050`. Example: `nepcal age 04-15-2050` or `nepcal age --from ad 07-30-1993`")
+
+		return cli.Exit("", 1)
+	}
+
+	on := nepcal.FromGregorianUnchecked(now)
+	if c.IsSet("on") {
+		if on, err = parseDateArg(c.String("on"), cs, now); err != nil {
+			fmt.Fprintln(os.Stderr, "Please supply a valid date to calculate the age on, in the same format as the date of birth.")
+
+			return cli.Exit("", 1)
+		}
+	}
+
+	if err := printAge(output(c, globalWriter), dob, on); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// Prints the age on 'on' of someone born on 'dob', e.g. "३१ वर्ष ० महिना २६ दिन".
+func printAge(w io.Writer, dob, on nepcal.Time) error {
+	if dob.After(on) {
+		return errFutureBirth
+	}
+
+	years, months, days := nepcal.Diff(dob, on)
+
+	_, err := fmt.Fprintf(w, "%s वर्ष %s महिना %s दिन\n", nepcal.Numeral(years), nepcal.Numeral(months), nepcal.Numeral(days))
+
+	return err
+}
+
+// Parses a date given as mm-dd-yyyy in the calendar system 'cs', or as a phrase
+// understood by nepcal.ParseFuzzy relative to 'now', such as "15 Shrawan 2050".
+// B.S. months have up to 32 days, so the day is only checked by 'cs'.
+func parseDateArg(s string, cs nepcal.CalendarSystem, now time.Time) (nepcal.Time, error) {
+	mm, dd, yy, ok := parseRawDateUpTo(s, maxDaysInMonth)
+	if !ok {
+		return nepcal.ParseFuzzy(s, nepcal.FromGregorianUnchecked(now))
+	}
+
+	y, m, d, err := nepcal.Convert(cs, nepcal.BikramSambat, yy, mm, dd)
+	if err != nil {
+		return nepcal.Time{}, err
+	}
+
+	return nepcal.Date(y, nepcal.Month(m), d)
+}
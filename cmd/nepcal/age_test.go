@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintAge(t *testing.T) {
+	tests := []struct {
+		name     string
+		dob, on  nepcal.Time
+		expected string
+	}{
+		{"newborn", nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), "० वर्ष ० महिना ० दिन\n"},
+		{"adult", nepcal.DateUnchecked(2050, nepcal.Baisakh, 15), nepcal.DateUnchecked(2081, nepcal.Jestha, 10), "३१ वर्ष ० महिना २६ दिन\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b bytes.Buffer
+			assert.NoError(t, printAge(&b, test.dob, test.on))
+			assert.Equal(t, test.expected, b.String())
+		})
+	}
+
+	t.Run("born in the future", func(t *testing.T) {
+		err := printAge(&bytes.Buffer{}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 16), nepcal.DateUnchecked(2081, nepcal.Shrawan, 15))
+		assert.Equal(t, errFutureBirth, err)
+	})
+}
+
+func TestParseDateArg(t *testing.T) {
+	now := time.Date(2024, time.July, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		raw      string
+		cs       nepcal.CalendarSystem
+		expected nepcal.Time
+		err      error
+	}{
+		{"B.S.", "04-15-2050", nepcal.BikramSambat, nepcal.DateUnchecked(2050, nepcal.Shrawan, 15), nil},
+		{"B.S. 32nd day", "02-32-2050", nepcal.BikramSambat, nepcal.DateUnchecked(2050, nepcal.Jestha, 32), nil},
+		{"B.S. day that does not exist", "01-32-2050", nepcal.BikramSambat, nepcal.Time{}, nepcal.ErrOutOfBounds},
+		{"A.D.", "07-30-1993", nepcal.Gregorian, nepcal.DateUnchecked(2050, nepcal.Shrawan, 15), nil},
+		{"phrase", "32 Shrawan 2050", nepcal.BikramSambat, nepcal.DateUnchecked(2050, nepcal.Shrawan, 32), nil},
+		{"relative", "-10d", nepcal.Gregorian, nepcal.DateUnchecked(2081, nepcal.Shrawan, 5), nil},
+		{"out of range", "01-01-1900", nepcal.Gregorian, nepcal.Time{}, nepcal.ErrOutOfBounds},
+		{"invalid", "someday", nepcal.BikramSambat, nepcal.Time{}, nepcal.ErrInvalidDate},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bs, err := parseDateArg(test.raw, test.cs, now)
+
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expected.Gregorian(), bs.Gregorian())
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+)
+
+// dateRe matches mm-dd-yyyy dates within arbitrary text.
+var dateRe = regexp.MustCompile(`\b\d{1,2}-\d{1,2}-\d{4}\b`)
+
+// Flags for converting dates in bulk, shared by the 'tobs' and 'toad' commands.
+func batchFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "input",
+			Usage: "Convert every mm-dd-yyyy date in this file instead of the argument; use - for stdin",
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "File to write the converted input into, instead of stdout",
+		},
+		&cli.IntFlag{
+			Name:  "column",
+			Usage: "Treat the input as CSV and convert the dates in this column (starting at 1), appending the results as a new column",
+		},
+		&cli.BoolFlag{
+			Name:  "header",
+			Usage: "Treat the first row of the CSV input as a header",
+		},
+	}
+}
+
+// Reports whether the dates are to be converted in bulk, which is the case if
+// the 'input' flag is set. The other batch flags only apply to the input, so
+// an error is returned if any of them is set without it.
+func batchMode(c *cli.Context) (bool, error) {
+	if c.IsSet("input") {
+		return true, nil
+	}
+
+	for _, name := range []string{"output", "column", "header"} {
+		if c.IsSet(name) {
+			return false, fmt.Errorf("The --%s flag requires --input; use --input - to read from stdin", name)
+		}
+	}
+
+	return false, nil
+}
+
+// Convert the dates in the file specified by the 'input' flag from the 'from'
+// calendar system to the 'to' calendar system. Dates that can not be converted
+// are reported on stderr without stopping the conversion.
+func (nepcalCli) convBatch(c *cli.Context, from, to nepcal.CalendarSystem) error {
+	in := io.Reader(os.Stdin)
+	if path := c.String("input"); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return cli.Exit("", 1)
+		}
+		defer f.Close()
+
+		in = f
+	}
+
+	out := globalWriter
+	if path := c.String("output"); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return cli.Exit("", 1)
+		}
+		defer f.Close()
+
+		out = f
+	}
+
+	b := batch{from: from, to: to, errw: os.Stderr}
+
+	var err error
+	if c.IsSet("column") {
+		err = b.convertCSV(in, out, c.Int("column"), c.Bool("header"))
+	} else {
+		err = b.convertLines(in, out)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	if b.failures > 0 {
+		fmt.Fprintf(os.Stderr, "%d of %d dates could not be converted\n", b.failures, b.total)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// batch holds the state of converting dates in bulk.
+type batch struct {
+	from, to nepcal.CalendarSystem
+
+	// Where errors for individual dates are reported.
+	errw io.Writer
+
+	// Number of dates seen, and the number that could not be converted.
+	total, failures int
+}
+
+// convertCSV converts the dates in the 1-indexed 'column' of every row,
+// appending the converted date as a new column. The new column is empty
+// for rows where the date could not be converted, and rows that are not
+// valid CSV are reported and left out of the output.
+func (b *batch) convertCSV(in io.Reader, out io.Writer, column int, header bool) error {
+	if column < 1 {
+		return fmt.Errorf("column must be 1 or more, got %d", column)
+	}
+
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+
+	w := csv.NewWriter(out)
+
+	for row := 1; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			b.total++
+			b.fail("row %d: %v", row, err)
+
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case header && row == 1:
+			record = append(record, b.to.Name())
+		case column > len(record):
+			b.total++
+			b.fail("row %d: no column %d", row, column)
+			record = append(record, "")
+		default:
+			converted, _ := b.convert(fmt.Sprintf("row %d", row), strings.TrimSpace(record[column-1]))
+			record = append(record, converted)
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+// convertLines copies every line of the input into the output, replacing every
+// mm-dd-yyyy date with the converted date. Dates that can not be converted are
+// left as they are.
+func (b *batch) convertLines(in io.Reader, out io.Writer) error {
+	// A bufio.Reader rather than a bufio.Scanner, so that lines of any length
+	// can be read.
+	r := bufio.NewReader(in)
+
+	for line := 1; ; line++ {
+		text, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if text == "" && err == io.EOF {
+			return nil
+		}
+
+		text = strings.TrimSuffix(strings.TrimSuffix(text, "\n"), "\r")
+		converted := dateRe.ReplaceAllStringFunc(text, func(date string) string {
+			if converted, ok := b.convert(fmt.Sprintf("line %d", line), date); ok {
+				return converted
+			}
+
+			return date
+		})
+
+		if _, err := fmt.Fprintln(out, converted); err != nil {
+			return err
+		}
+	}
+}
+
+// convert converts a single mm-dd-yyyy date, reporting any errors with the
+// location of the date in the input.
+func (b *batch) convert(location, date string) (string, bool) {
+	b.total++
+
+	parts := strings.Split(date, "-")
+	if len(parts) != 3 {
+		b.fail("%s: invalid date %q", location, date)
+
+		return "", false
+	}
+
+	yy, mm, dd, ok := parseISODate(strings.Join([]string{parts[2], parts[0], parts[1]}, "-"))
+	if !ok {
+		b.fail("%s: invalid date %q", location, date)
+
+		return "", false
+	}
+
+	y, m, d, err := nepcal.Convert(b.from, b.to, yy, mm, dd)
+	if err != nil {
+		b.fail("%s: %s date %q does not exist or is out of the supported range", location, b.from.Name(), date)
+
+		return "", false
+	}
+
+	return fmt.Sprintf("%02d-%02d-%04d", m, d, y), true
+}
+
+// fail reports an error for a single date.
+func (b *batch) fail(format string, args ...interface{}) {
+	b.failures++
+
+	fmt.Fprintf(b.errw, format+"\n", args...)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+func TestConvertCSV(t *testing.T) {
+	in := strings.Join([]string{
+		"id,amount,date",
+		"1,100,08-21-1994",
+		"2,200,02-30-2019",
+		"3,300",
+		"4,400,12-03-1996",
+		"5,500,01-01-1900",
+	}, "\n")
+
+	out := bytes.NewBuffer([]byte(""))
+	errw := bytes.NewBuffer([]byte(""))
+
+	b := batch{from: nepcal.Gregorian, to: nepcal.BikramSambat, errw: errw}
+	assert.NoError(t, b.convertCSV(strings.NewReader(in), out, 3, true))
+
+	assert.Equal(t, strings.Join([]string{
+		"id,amount,date,Bikram Sambat",
+		"1,100,08-21-1994,05-05-2051",
+		"2,200,02-30-2019,",
+		"3,300,",
+		"4,400,12-03-1996,08-18-2053",
+		"5,500,01-01-1900,",
+		"",
+	}, "\n"), out.String())
+
+	assert.Equal(t, strings.Join([]string{
+		`row 3: Gregorian date "02-30-2019" does not exist or is out of the supported range`,
+		"row 4: no column 3",
+		`row 6: Gregorian date "01-01-1900" does not exist or is out of the supported range`,
+		"",
+	}, "\n"), errw.String())
+
+	assert.Equal(t, 5, b.total)
+	assert.Equal(t, 3, b.failures)
+
+	t.Run("invalid column", func(t *testing.T) {
+		assert.Error(t, b.convertCSV(strings.NewReader(in), out, 0, false))
+	})
+
+	t.Run("malformed row", func(t *testing.T) {
+		in := strings.Join([]string{
+			"1,100,08-21-1994",
+			`2,2"00,02-20-2019`,
+			"3,300,12-03-1996",
+		}, "\n")
+
+		out := bytes.NewBuffer([]byte(""))
+		errw := bytes.NewBuffer([]byte(""))
+
+		b := batch{from: nepcal.Gregorian, to: nepcal.BikramSambat, errw: errw}
+		assert.NoError(t, b.convertCSV(strings.NewReader(in), out, 3, false))
+
+		assert.Equal(t, strings.Join([]string{
+			"1,100,08-21-1994,05-05-2051",
+			"3,300,12-03-1996,08-18-2053",
+			"",
+		}, "\n"), out.String())
+
+		assert.Equal(t, `row 2: parse error on line 2, column 4: bare " in non-quoted-field`+"\n", errw.String())
+		assert.Equal(t, 3, b.total)
+		assert.Equal(t, 1, b.failures)
+	})
+
+	t.Run("malformed first row without a header", func(t *testing.T) {
+		in := strings.Join([]string{
+			`1,1"00,08-21-1994`,
+			"2,200,12-03-1996",
+		}, "\n")
+
+		out := bytes.NewBuffer([]byte(""))
+		errw := bytes.NewBuffer([]byte(""))
+
+		b := batch{from: nepcal.Gregorian, to: nepcal.BikramSambat, errw: errw}
+		assert.NoError(t, b.convertCSV(strings.NewReader(in), out, 3, false))
+
+		// The next row is data, not a header.
+		assert.Equal(t, "2,200,12-03-1996,08-18-2053\n", out.String())
+		assert.Equal(t, `row 1: parse error on line 1, column 4: bare " in non-quoted-field`+"\n", errw.String())
+		assert.Equal(t, 2, b.total)
+		assert.Equal(t, 1, b.failures)
+	})
+}
+
+func TestBatchMode(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		bulk bool
+		err  string
+	}{
+		{"single date", []string{"08-21-1994"}, false, ""},
+		{"input", []string{"--input", "-", "--column", "3", "--header"}, true, ""},
+		{"column without input", []string{"--column", "3", "08-21-1994"}, false, "The --column flag requires --input; use --input - to read from stdin"},
+		{"header without input", []string{"--header"}, false, "The --header flag requires --input; use --input - to read from stdin"},
+		{"output without input", []string{"--output", "out.txt"}, false, "The --output flag requires --input; use --input - to read from stdin"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+			for _, f := range batchFlags() {
+				assert.NoError(t, f.Apply(set))
+			}
+			assert.NoError(t, set.Parse(test.args))
+
+			bulk, err := batchMode(cli.NewContext(nil, set, nil))
+
+			assert.Equal(t, test.bulk, bulk)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestConvertLines(t *testing.T) {
+	in := strings.Join([]string{
+		"paid on 08-18-2053 and 02-32-2076",
+		"no dates here",
+		"bad 13-01-2053, out of range 01-01-2200",
+	}, "\n")
+
+	out := bytes.NewBuffer([]byte(""))
+	errw := bytes.NewBuffer([]byte(""))
+
+	b := batch{from: nepcal.BikramSambat, to: nepcal.Gregorian, errw: errw}
+	assert.NoError(t, b.convertLines(strings.NewReader(in), out))
+
+	assert.Equal(t, strings.Join([]string{
+		"paid on 12-03-1996 and 06-15-2019",
+		"no dates here",
+		"bad 13-01-2053, out of range 01-01-2200",
+		"",
+	}, "\n"), out.String())
+
+	assert.Equal(t, strings.Join([]string{
+		`line 3: invalid date "13-01-2053"`,
+		`line 3: Bikram Sambat date "01-01-2200" does not exist or is out of the supported range`,
+		"",
+	}, "\n"), errw.String())
+
+	assert.Equal(t, 4, b.total)
+	assert.Equal(t, 2, b.failures)
+}
+
+func TestConvertLinesLong(t *testing.T) {
+	long := strings.Repeat("x", 100*1024)
+	in := long + " 08-18-2053\r\nlast 08-18-2053"
+
+	out := bytes.NewBuffer([]byte(""))
+
+	b := batch{from: nepcal.BikramSambat, to: nepcal.Gregorian, errw: bytes.NewBuffer([]byte(""))}
+	assert.NoError(t, b.convertLines(strings.NewReader(in), out))
+
+	assert.Equal(t, long+" 12-03-1996\nlast 12-03-1996\n", out.String())
+	assert.Equal(t, 0, b.failures)
+}
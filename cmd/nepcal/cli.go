@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -18,35 +19,71 @@ type nepcalCli struct{}
 
 // Shows the calendar for the current day.
 func (nepcalCli) showCalendar(c *cli.Context) error {
+	if format := c.String("format"); format != "" {
+		if err := printCalendarFormatted(output(c, globalWriter), format, nepcal.Now()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return cli.Exit("", 1)
+		}
+
+		return nil
+	}
+
 	// get the calendar representation
 	calReader := nepcal.CalendarNow()
 
 	// stream into globalwriter
-	io.Copy(globalWriter, calReader)
+	io.Copy(output(c, globalWriter), calReader)
 
 	return nil
 }
 
 // Shows the date for the provided time. Returns a cli 'action'.
-func (nepcalCli) showDate(w io.Writer, t time.Time) func(c *cli.Context) error {
+func (nc nepcalCli) showDate(w io.Writer, t time.Time) func(c *cli.Context) error {
 	return func(c *cli.Context) error {
 		// This will stop working in year bsUBoundY + 1 (:
 		bs := nepcal.FromGregorianUnchecked(t)
 
-		fmt.Fprintln(w, bs.String())
+		if c.String("format") != "" {
+			return nc.printFormatted(c, w, bs, nepcal.BikramSambat)
+		}
+
+		fmt.Fprintln(output(c, w), bs.String())
 
 		return nil
 	}
 }
 
 // Convert AD date to BS date after validation.
-func (nepcalCli) convADToBS(c *cli.Context) error {
-	if !validateArgs(c) {
-		fmt.Fprintln(os.Stderr, "Please supply a valid date in the format mm-dd-yyyy. Example: `nepcal conv tobs 08-21-1994`")
+func (nc nepcalCli) convADToBS(c *cli.Context) error {
+	bulk, err := batchMode(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
 
 		return cli.Exit("", 1)
 	}
 
+	if bulk {
+		return nc.convBatch(c, nepcal.Gregorian, nepcal.BikramSambat)
+	}
+
+	if !validateArgs(c) {
+		bs, ok := parseFuzzyArgs(c, time.Now())
+		if !ok {
+			fmt.Fprintln(os.Stderr, "Please supply a valid date in the format mm-dd-yyyy, or a phrase such as `today` or `next friday`. Example: `nepcal conv tobs 08-21-1994`")
+
+			return cli.Exit("", 1)
+		}
+
+		if c.String("format") != "" {
+			return nc.printFormatted(c, globalWriter, bs, nepcal.BikramSambat)
+		}
+
+		fmt.Fprintln(output(c, globalWriter), bs.String())
+
+		return nil
+	}
+
 	mm, dd, yy, _ := parseRawDate(c.Args().First())
 
 	ad := gregorian(yy, mm, dd)
@@ -57,20 +94,45 @@ func (nepcalCli) convADToBS(c *cli.Context) error {
 		return cli.Exit("", 1)
 	}
 
-	fmt.Fprintln(globalWriter, bs.String())
+	if c.String("format") != "" {
+		return nc.printFormatted(c, globalWriter, bs, nepcal.BikramSambat)
+	}
+
+	fmt.Fprintln(output(c, globalWriter), bs.String())
 
 	return nil
 }
 
 // Convert BS date to AD date after validation.
-func (nepcalCli) convBSToAD(c *cli.Context) error {
-	if !validateArgs(c) {
-		fmt.Fprintln(os.Stderr, "Please supply a valid date in the format mm-dd-yyyy. Example: `nepcal conv toad 08-18-2053`")
+func (nc nepcalCli) convBSToAD(c *cli.Context) error {
+	bulk, err := batchMode(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
 
 		return cli.Exit("", 1)
 	}
 
-	mm, dd, yy, _ := parseRawDate(c.Args().First())
+	if bulk {
+		return nc.convBatch(c, nepcal.BikramSambat, nepcal.Gregorian)
+	}
+
+	mm, dd, yy, ok := parseRawDateUpTo(c.Args().First(), maxDaysInMonth)
+	if !ok {
+		bs, ok := parseFuzzyArgs(c, time.Now())
+		if !ok {
+			fmt.Fprintln(os.Stderr, "Please supply a valid date in the format mm-dd-yyyy, or a phrase such as `today` or `15 Shrawan 2081`. Example: `nepcal conv toad 08-18-2053`")
+
+			return cli.Exit("", 1)
+		}
+
+		if c.String("format") != "" {
+			return nc.printFormatted(c, globalWriter, bs, nepcal.Gregorian)
+		}
+
+		printGregorian(globalWriter, bs.Gregorian())
+
+		return nil
+	}
 
 	d, err := nepcal.Date(yy, nepcal.Month(mm), dd)
 	if err != nil {
@@ -79,11 +141,122 @@ func (nepcalCli) convBSToAD(c *cli.Context) error {
 		return cli.Exit("", 1)
 	}
 
+	if c.String("format") != "" {
+		return nc.printFormatted(c, globalWriter, d, nepcal.Gregorian)
+	}
+
 	printGregorian(globalWriter, d.Gregorian())
 
 	return nil
 }
 
+// Convert a date between any two calendar systems, as specified by the
+// 'from' and 'to' flags, after validation.
+func (nc nepcalCli) convBetween(c *cli.Context) error {
+	from, ok := nepcal.LookupCalendarSystem(c.String("from"))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown calendar system %q. Supported systems: %s\n", c.String("from"), strings.Join(nepcal.CalendarSystemNames(), ", "))
+
+		return cli.Exit("", 1)
+	}
+
+	to, ok := nepcal.LookupCalendarSystem(c.String("to"))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown calendar system %q. Supported systems: %s\n", c.String("to"), strings.Join(nepcal.CalendarSystemNames(), ", "))
+
+		return cli.Exit("", 1)
+	}
+
+	// Months have up to 32 days in B.S., so the day is only checked against the
+	// month of the source system by Convert.
+	mm, dd, yy, ok := parseRawDateUpTo(c.Args().First(), maxDaysInMonth)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Please supply a valid date in the format mm-dd-yyyy. Example: `%s`\n", convExample(c, from))
+
+		return cli.Exit("", 1)
+	}
+
+	y, m, d, err := nepcal.Convert(from, to, yy, mm, dd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "The date is not supported by the %s and %s calendar systems.\n", from.Name(), to.Name())
+
+		return cli.Exit("", 1)
+	}
+
+	if c.String("format") != "" {
+		// The structured formats contain the B.S. date, so the date must be in its range.
+		jdn, _ := to.JulianDay(y, m, d)
+		t, err := nepcal.FromJulianDay(jdn)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Formatted output is only supported for dates within the B.S. calendar's range.")
+
+			return cli.Exit("", 1)
+		}
+
+		return nc.printFormatted(c, globalWriter, t, to)
+	}
+
+	printSystemDate(output(c, globalWriter), to, y, m, d)
+
+	return nil
+}
+
+// Returns an example of the 'conv' command with the 'from' and 'to' flags in
+// 'c', converting a date in the calendar system 'from'.
+func convExample(c *cli.Context, from nepcal.CalendarSystem) string {
+	date := "08-21-1994"
+	if y, m, d, err := nepcal.Convert(nepcal.Gregorian, from, 1994, 8, 21); err == nil {
+		date = fmt.Sprintf("%02d-%02d-%04d", m, d, y)
+	}
+
+	return fmt.Sprintf("nepcal conv --from %s --to %s %s", c.String("from"), c.String("to"), date)
+}
+
+// Runs the HTTP JSON conversion service, along with the gRPC Converter service,
+// on the address in the 'addr' flag.
+func (nepcalCli) serve(c *cli.Context) error {
+	srv := &http.Server{
+		Addr:         c.String("addr"),
+		Handler:      withGRPC(newServer(time.Now), time.Now),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	fmt.Fprintf(os.Stderr, "Listening on %s\n", srv.Addr)
+
+	if err := srv.ListenAndServe(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// Returns the writer that a command should print into. If the 'ascii' flag is set,
+// everything written is transliterated from Devanagari into ASCII.
+func output(c *cli.Context, w io.Writer) io.Writer {
+	if c.Bool("ascii") {
+		return asciiWriter{w}
+	}
+
+	return w
+}
+
+// asciiWriter is an io.Writer that transliterates Devanagari into ASCII before
+// writing into the underlying writer. Each write is expected to contain whole words.
+type asciiWriter struct {
+	w io.Writer
+}
+
+func (a asciiWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(a.w, nepcal.Transliterate(string(p), nepcal.ASCII)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
 // Validates the arguments provided to the program.
 func validateArgs(c *cli.Context) bool {
 	if c.NArg() < 1 {
@@ -95,9 +268,32 @@ func validateArgs(c *cli.Context) bool {
 	return ok
 }
 
+// Parses all the arguments provided to the program as a single natural language
+// date, such as "next friday" or "15 Shrawan 2081", relative to 'now'. The
+// boolean indicates if the date is valid or not.
+func parseFuzzyArgs(c *cli.Context, now time.Time) (nepcal.Time, bool) {
+	if c.NArg() < 1 {
+		return nepcal.Time{}, false
+	}
+
+	t, err := nepcal.ParseFuzzy(strings.Join(c.Args().Slice(), " "), nepcal.FromGregorianUnchecked(now))
+
+	return t, err == nil
+}
+
+// maxDaysInMonth is the most days that a month has in any of the calendar
+// systems, i.e. the 32 days of the longest B.S. months.
+const maxDaysInMonth = 32
+
 // Parse user input raw date into valid dd, mm, yy format. The last parameter is a boolean indicating if
 // the date is valid or not.
 func parseRawDate(rawDate string) (int, int, int, bool) {
+	return parseRawDateUpTo(rawDate, 31)
+}
+
+// Like parseRawDate, but accepts days up to 'maxDay' for calendar systems
+// other than A.D., leaving it to the caller to check the day against the month.
+func parseRawDateUpTo(rawDate string, maxDay int) (int, int, int, bool) {
 	dateParts := strings.Split(rawDate, "-")
 	if len(dateParts) != 3 {
 		return -1, -1, -1, false
@@ -118,7 +314,7 @@ func parseRawDate(rawDate string) (int, int, int, bool) {
 		return -1, -1, -1, false
 	}
 
-	if dd < 1 || dd > 31 || mm < 1 || mm > 12 || len(dateParts[2]) != 4 {
+	if dd < 1 || dd > maxDay || mm < 1 || mm > 12 || len(dateParts[2]) != 4 {
 		return -1, -1, -1, false
 	}
 
@@ -133,6 +329,11 @@ func printGregorian(w io.Writer, t time.Time) {
 	fmt.Fprintf(w, "%s %d, %d %s\n", month, addd, adyy, weekday)
 }
 
+// printSystemDate prints a date belonging to the calendar system 'cs'.
+func printSystemDate(w io.Writer, cs nepcal.CalendarSystem, yy, mm, dd int) {
+	fmt.Fprintf(w, "%s %d, %d\n", cs.MonthName(mm), dd, yy)
+}
+
 // gregorian creates a new time.Time with the basic yy/mm/dd parameters.
 // Crucially, the time returned is in UTC.
 func gregorian(yy, mm, dd int) time.Time {
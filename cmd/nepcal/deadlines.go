@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/compliance"
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+)
+
+// Output format of the 'deadlines' command for spreadsheets, in addition to
+// the structured formats.
+const formatCSV = "csv"
+
+// deadlineJSON is the structured representation of a compliance deadline.
+type deadlineJSON struct {
+	Date        dateJSON        `json:"date" yaml:"date"`
+	Kind        compliance.Kind `json:"kind" yaml:"kind"`
+	Description string          `json:"description" yaml:"description"`
+}
+
+// Flags for the 'deadlines' command.
+func deadlinesFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Print the deadlines as json, yaml or csv",
+		},
+	}
+}
+
+// Shows the tax deadlines for the fiscal year in the arguments, or the current
+// fiscal year if there are none.
+func (nepcalCli) showDeadlines(c *cli.Context) error {
+	fy, ok := parseFiscalYear(c.Args().First(), time.Now())
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Please supply a valid B.S. fiscal year. Example: `nepcal deadlines 2081/82` or `nepcal deadlines 2081`")
+
+		return cli.Exit("", 1)
+	}
+
+	deadlines, err := compliance.Deadlines(fy)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "The fiscal year is outside the supported date range.")
+
+		return cli.Exit("", 1)
+	}
+
+	if err := printDeadlines(output(c, globalWriter), c.String("format"), deadlines); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// Prints the deadlines in the 'format', or one per line with their B.S. and
+// A.D. dates if it is empty.
+func printDeadlines(w io.Writer, format string, deadlines []compliance.Deadline) error {
+	switch format {
+	case "":
+		for _, d := range deadlines {
+			if _, err := fmt.Fprintf(w, "%s (%s)  %s\n", d.Date.Format(nepcal.ISODate), d.Date.Gregorian().Format("2006-01-02"), d.Description); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case formatJSON, formatYAML:
+		v := make([]deadlineJSON, len(deadlines))
+		for i, d := range deadlines {
+			v[i] = deadlineJSON{newDateJSON(d.Date), d.Kind, d.Description}
+		}
+
+		return encode(w, format, v)
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"bs_date", "ad_date", "kind", "description"}); err != nil {
+			return err
+		}
+
+		for _, d := range deadlines {
+			if err := cw.Write([]string{d.Date.Format(nepcal.ISODate), d.Date.Gregorian().Format("2006-01-02"), d.Kind.String(), d.Description}); err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	}
+
+	return fmt.Errorf("Unknown format %q. Supported formats: json, yaml, csv", format)
+}
+
+// Parses a fiscal year such as "2081/82" or "2081" into the B.S. year it starts
+// in. An empty string is the fiscal year that 'now' is in. The boolean indicates
+// if the fiscal year is valid or not.
+func parseFiscalYear(s string, now time.Time) (int, bool) {
+	if s == "" {
+		return compliance.FiscalYear(nepcal.FromGregorianUnchecked(now)), true
+	}
+
+	parts := strings.Split(s, "/")
+	if len(parts) > 2 || len(parts[0]) != 4 {
+		return 0, false
+	}
+
+	fy, err := strconv.Atoi(parts[0])
+	if err != nil || fy < 0 {
+		return 0, false
+	}
+
+	// The second part, if any, must be the next year, either in full or its last two digits.
+	if len(parts) == 2 {
+		next := strconv.Itoa(fy + 1)
+		if parts[1] != next && parts[1] != next[len(next)-2:] {
+			return 0, false
+		}
+	}
+
+	return fy, true
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/compliance"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDeadlines(t *testing.T) {
+	deadlines, err := compliance.Deadlines(2081)
+	assert.NoError(t, err)
+
+	t.Run("text", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+		assert.NoError(t, printDeadlines(b, "", deadlines[:2]))
+
+		assert.Equal(t, "2081-05-25 (2024-09-10)  VAT return and payment for Shrawan 2081\n2081-05-25 (2024-09-10)  TDS deposit and return for Shrawan 2081\n", b.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+		assert.NoError(t, printDeadlines(b, formatJSON, deadlines[:1]))
+
+		var v []deadlineJSON
+		assert.NoError(t, json.Unmarshal(b.Bytes(), &v))
+
+		assert.Equal(t, "2081-05-25", v[0].Date.BS.Date)
+		assert.Equal(t, "2024-09-10", v[0].Date.AD.Date)
+		assert.Equal(t, compliance.VAT, v[0].Kind)
+		assert.Equal(t, "VAT return and payment for Shrawan 2081", v[0].Description)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+		assert.NoError(t, printDeadlines(b, formatCSV, deadlines[len(deadlines)-1:]))
+
+		assert.Equal(t, "bs_date,ad_date,kind,description\n2082-06-31,2025-10-17,Income tax return,Income tax return for FY 2081/82\n", b.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		assert.EqualError(t, printDeadlines(bytes.NewBuffer(nil), "xml", deadlines), `Unknown format "xml". Supported formats: json, yaml, csv`)
+	})
+}
+
+func TestParseFiscalYear(t *testing.T) {
+	now := time.Date(2024, time.July, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		s        string
+		expected int
+		ok       bool
+	}{
+		{"", 2080, true},
+		{"2081", 2081, true},
+		{"2081/82", 2081, true},
+		{"2081/2082", 2081, true},
+		{"2099/00", 2099, true},
+		{"2081/83", 0, false},
+		{"81/82", 0, false},
+		{"2081/82/83", 0, false},
+		{"-081", 0, false},
+		{"abcd", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			fy, ok := parseFiscalYear(test.s, now)
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, fy)
+		})
+	}
+}
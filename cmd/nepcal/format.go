@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Machine readable output formats. A value of the 'format' flag containing "{{"
+// is treated as a text/template executed with the structured representation of
+// the date, and any other value as a custom layout, as accepted by
+// nepcal.Time.Format for B.S. dates and time.Time.Format for A.D. dates.
+const (
+	formatJSON = "json"
+	formatYAML = "yaml"
+	formatISO  = "iso"
+)
+
+// Flag selecting the output format of the 'date', 'conv' and 'cal' commands.
+func formatFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "format",
+		Usage: "Print the result as json, yaml, iso, in a custom layout such as \"Monday, 2 January 2006\", or using a template such as \"{{.BS.Date}} {{.Weekday.English}}\"",
+	}
+}
+
+// Prints the date 't' in the machine readable 'format'. The structured formats
+// and templates have access to both the B.S. and A.D. dates, whereas the iso
+// format and custom layouts write the date in the calendar system 'cs'.
+func printDateFormatted(w io.Writer, format string, t nepcal.Time, cs nepcal.CalendarSystem) error {
+	switch format {
+	case formatJSON, formatYAML:
+		return encode(w, format, newDateJSON(t))
+	}
+
+	s, err := formatDate(format, t, cs)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, s)
+
+	return err
+}
+
+// Formats the date 't' using the iso format, a template or a custom layout.
+func formatDate(format string, t nepcal.Time, cs nepcal.CalendarSystem) (string, error) {
+	switch {
+	case format == formatISO:
+		format = nepcal.ISODate
+	case strings.Contains(format, "{{"):
+		return executeTemplate(format, t)
+	}
+
+	if !isLayout(format, cs) {
+		return "", fmt.Errorf("Unknown format %q. Supported formats: json, yaml, iso, a layout such as \"Monday, 2 January 2006\" or a template such as \"{{.BS.Date}}\"", format)
+	}
+
+	if cs == nepcal.Gregorian {
+		return t.Gregorian().Format(format), nil
+	}
+
+	return t.Format(format), nil
+}
+
+// Reports whether 'format' is a layout for the calendar system 'cs', i.e.
+// whether it contains at least one element that is replaced when formatting.
+// The layout is checked by formatting a date that does not share any of the
+// values of the reference date, so that every element changes the output.
+func isLayout(format string, cs nepcal.CalendarSystem) bool {
+	if cs == nepcal.Gregorian {
+		return time.Date(1996, time.December, 3, 0, 0, 0, 0, time.UTC).Format(format) != format
+	}
+
+	return nepcal.DateUnchecked(2053, nepcal.Mangshir, 18).Format(format) != format
+}
+
+// Executes 'text' as a template with the structured representation of 't' as
+// its data, e.g. "{{.BS.Date}} ({{.AD.Date}})". In addition to the builtin
+// functions, templates can use:
+//
+//	numeral  to write a number in Nepali numerals, e.g. {{numeral .BS.Day}}
+//	layout   to write the B.S. date in a custom layout, e.g. {{layout "२ बैशाख"}}
+func executeTemplate(text string, t nepcal.Time) (string, error) {
+	funcs := template.FuncMap{
+		"numeral": func(n int) string { return nepcal.Numeral(n).String() },
+		"layout":  t.Format,
+	}
+
+	tmpl, err := template.New("format").Funcs(funcs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, newDateJSON(t)); err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+// Prints the month that 't' is in using the machine readable 'format'. With the
+// iso format and custom layouts, every day of the month is printed on its own line.
+func printCalendarFormatted(w io.Writer, format string, t nepcal.Time) error {
+	cal := newCalendarJSON(t)
+
+	switch format {
+	case formatJSON, formatYAML:
+		return encode(w, format, cal)
+	}
+
+	for d := 1; d <= cal.NumDays; d++ {
+		if err := printDateFormatted(w, format, nepcal.DateUnchecked(cal.Year, nepcal.Month(cal.Month), d), nepcal.BikramSambat); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encode writes 'v' into 'w' as either indented JSON or YAML.
+func encode(w io.Writer, format string, v interface{}) error {
+	if format == formatYAML {
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+
+		return enc.Close()
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// Prints the date 't' into 'w' in the format requested by the 'format' flag.
+func (nepcalCli) printFormatted(c *cli.Context, w io.Writer, t nepcal.Time, cs nepcal.CalendarSystem) error {
+	if err := printDateFormatted(output(c, w), c.String("format"), t, cs); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
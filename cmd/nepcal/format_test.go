@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDateFormatted(t *testing.T) {
+	date := nepcal.DateUnchecked(2053, nepcal.Mangshir, 18)
+
+	tests := []struct {
+		name     string
+		format   string
+		cs       nepcal.CalendarSystem
+		expected string
+	}{
+		{"bs iso", "iso", nepcal.BikramSambat, "2053-08-18\n"},
+		{"ad iso", "iso", nepcal.Gregorian, "1996-12-03\n"},
+		{"bs layout", "सोमबार, २ बैशाख २००६", nepcal.BikramSambat, "मंगलबार, १८ मंसिर २०५३\n"},
+		{"bs latin layout", "Mon, 2 Jan 2006", nepcal.BikramSambat, "Tue, 18 Man 2053\n"},
+		{"ad layout", "Monday, 2 January 2006", nepcal.Gregorian, "Tuesday, 3 December 1996\n"},
+		{"yaml", "yaml", nepcal.Gregorian, strings.Join([]string{
+			"bs:",
+			"  year: 2053",
+			"  month: 8",
+			"  day: 18",
+			"  monthName: मंसिर",
+			`  date: "2053-08-18"`,
+			"  numeral: २०५३-०८-१८",
+			"  formatted: मंसिर १८, २०५३ मंगलबार",
+			"ad:",
+			"  year: 1996",
+			"  month: 12",
+			"  day: 3",
+			"  monthName: December",
+			`  date: "1996-12-03"`,
+			"weekday:",
+			"  index: 2",
+			"  name: मंगलबार",
+			"  english: Tuesday",
+			"julianDay: 2450421",
+			"",
+		}, "\n")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := bytes.NewBuffer([]byte(""))
+
+			assert.NoError(t, printDateFormatted(b, test.format, date, test.cs))
+			assert.Equal(t, test.expected, b.String())
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+
+		assert.NoError(t, printDateFormatted(b, "json", date, nepcal.BikramSambat))
+		assert.Contains(t, b.String(), "\"bs\": {\n    \"year\": 2053,")
+		assert.Contains(t, b.String(), `"date": "1996-12-03"`)
+		assert.Contains(t, b.String(), `"julianDay": 2450421`)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		for _, cs := range []nepcal.CalendarSystem{nepcal.BikramSambat, nepcal.Gregorian} {
+			b := bytes.NewBuffer([]byte(""))
+
+			err := printDateFormatted(b, "jsno", date, cs)
+			assert.EqualError(t, err, `Unknown format "jsno". Supported formats: json, yaml, iso, a layout such as "Monday, 2 January 2006" or a template such as "{{.BS.Date}}"`)
+			assert.Empty(t, b.String())
+		}
+	})
+}
+
+func TestPrintCalendarFormatted(t *testing.T) {
+	date := nepcal.DateUnchecked(2081, nepcal.Shrawan, 15)
+
+	t.Run("iso", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+
+		assert.NoError(t, printCalendarFormatted(b, "iso", date))
+
+		lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+		assert.Len(t, lines, 32)
+		assert.Equal(t, "2081-04-01", lines[0])
+		assert.Equal(t, "2081-04-32", lines[31])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b := bytes.NewBuffer([]byte(""))
+
+		assert.NoError(t, printCalendarFormatted(b, "json", date))
+		assert.Contains(t, b.String(), `"numDays": 32`)
+		assert.Contains(t, b.String(), `"monthName": "साउन"`)
+	})
+}
+
+func TestFormatDateTemplate(t *testing.T) {
+	date := nepcal.DateUnchecked(2081, nepcal.Shrawan, 15)
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"fields", "{{.BS.Date}} ({{.AD.Date}})", "2081-04-15 (2024-07-30)"},
+		{"weekday", "{{.Weekday.Name}} {{.Weekday.English}}", "मंगलबार Tuesday"},
+		{"numeral", "{{numeral .BS.Day}} {{.BS.MonthName}}", "१५ साउन"},
+		{"layout", `{{layout "२ बैशाख"}}, {{.AD.MonthName}} {{.AD.Day}}`, "१५ साउन, July 30"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := formatDate(test.template, date, nepcal.BikramSambat)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, s)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := formatDate("{{.Missing}}", date, nepcal.BikramSambat)
+		assert.Error(t, err)
+
+		_, err = formatDate("{{.BS.Date", date, nepcal.BikramSambat)
+		assert.Error(t, err)
+	})
+}
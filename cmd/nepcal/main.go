@@ -1,13 +1,14 @@
 package main
 
 import (
-	"flag"
 	"fmt"
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/srishanbhattarai/nepcal/nepcal"
 	"github.com/urfave/cli/v2"
 )
 
@@ -31,7 +32,6 @@ func runCli() {
 }
 
 func bootstrapCli() *cli.App {
-	flag.Parse()
 	nc := nepcalCli{}
 
 	app := &cli.App{
@@ -41,6 +41,12 @@ func bootstrapCli() *cli.App {
 		HideVersion:     false,
 		HideHelpCommand: false,
 		Action:          nc.showCalendar,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "ascii",
+				Usage: "Transliterate Devanagari output into ASCII",
+			},
+		},
 		CommandNotFound: func(c *cli.Context, command string) {
 			fmt.Printf("No matching sub command: %s\n\n", command)
 			cli.ShowAppHelpAndExit(c, 1)
@@ -56,26 +62,88 @@ func bootstrapCli() *cli.App {
 				Name:    "cal",
 				Aliases: []string{"c"},
 				Usage:   "Show calendar for the month",
+				Flags:   []cli.Flag{formatFlag()},
 				Action:  nc.showCalendar,
 			},
 			{
 				Name:    "date",
 				Aliases: []string{"d"},
 				Usage:   "Show today's date",
+				Flags:   []cli.Flag{formatFlag()},
 				Action:  nc.showDate(globalWriter, time.Now()),
 			},
 			{
-				Name:  "conv",
-				Usage: "Convert AD dates to BS and vice-versa",
+				Name:      "age",
+				Usage:     "Show the age in B.S. years, months and days of someone born on a date",
+				ArgsUsage: "mm-dd-yyyy",
+				Flags:     ageFlags(),
+				Action:    nc.showAge,
+			},
+			{
+				Name:      "deadlines",
+				Usage:     "Show the IRD tax deadlines of a B.S. fiscal year",
+				ArgsUsage: "yyyy/yy",
+				Flags:     deadlinesFlags(),
+				Action:    nc.showDeadlines,
+			},
+			{
+				Name:   "status",
+				Usage:  "Show today's date for shell prompts and status bars",
+				Flags:  statusFlags(),
+				Action: nc.showStatus,
+			},
+			{
+				Name:  "tui",
+				Usage: "Browse the calendar interactively",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "events",
+						Usage: "JSON file with the tithi and holidays of each B.S. date, e.g. {\"2081-06-27\": {\"tithi\": \"दशमी\", \"holidays\": [\"विजया दशमी\"]}}",
+					},
+				},
+				Action: nc.showTUI,
+			},
+			{
+				Name:  "serve",
+				Usage: "Serve conversions over HTTP as JSON",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "addr",
+						Usage: "Address to listen on",
+						Value: ":8080",
+					},
+				},
+				Action: nc.serve,
+			},
+			{
+				Name:      "conv",
+				Usage:     "Convert AD dates to BS and vice-versa",
+				ArgsUsage: "mm-dd-yyyy",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "from",
+						Usage: "Calendar system of the input date (" + strings.Join(nepcal.CalendarSystemNames(), ", ") + ")",
+						Value: "ad",
+					},
+					&cli.StringFlag{
+						Name:  "to",
+						Usage: "Calendar system to convert the date into",
+						Value: "bs",
+					},
+					formatFlag(),
+				},
+				Action: nc.convBetween,
 				Subcommands: []*cli.Command{
 					{
 						Name:   "tobs",
 						Usage:  "Convert AD date to BS date",
+						Flags:  append(batchFlags(), formatFlag()),
 						Action: nc.convADToBS,
 					},
 					{
 						Name:   "toad",
 						Usage:  "Convert BS date to AD date",
+						Flags:  append(batchFlags(), formatFlag()),
 						Action: nc.convBSToAD,
 					},
 				},
@@ -2,12 +2,15 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/srishanbhattarai/nepcal/nepcal"
 	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
 )
 
 func TestShowDateBS(t *testing.T) {
@@ -67,6 +70,7 @@ func TestParseRawDate(t *testing.T) {
 		ok   bool
 	}{
 		{"valid date", "08-21-1994", 1994, 8, 21, true},
+		{"32nd day", "02-32-2050", -1, -1, -1, false},
 		{"overflow day", "08-35-1994", -1, -1, -1, false},
 		{"underflow day", "08-00-1994", -1, -1, -1, false},
 		{"overflow month", "14-21-1994", -1, -1, -1, false},
@@ -92,6 +96,33 @@ func TestParseRawDate(t *testing.T) {
 	}
 }
 
+func FuzzParseRawDate(f *testing.F) {
+	for _, s := range []string{"08-21-1994", "08-35-1994", "14-21-199", "08-21--994", "+8-+1-+994", "08-21-1994-01", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		mm, dd, yy, ok := parseRawDate(raw)
+		if !ok {
+			if mm != -1 || dd != -1 || yy != -1 {
+				t.Fatalf("parseRawDate(%q) = %d, %d, %d, false", raw, mm, dd, yy)
+			}
+
+			return
+		}
+
+		if mm < 1 || mm > 12 || dd < 1 || dd > 31 || yy < 0 || yy > 9999 {
+			t.Fatalf("parseRawDate(%q) = %d, %d, %d, which is out of range", raw, mm, dd, yy)
+		}
+
+		// The canonical form of the date parses to the same date.
+		canonical := fmt.Sprintf("%02d-%02d-%04d", mm, dd, yy)
+		if m, d, y, ok := parseRawDate(canonical); !ok || m != mm || d != dd || y != yy {
+			t.Fatalf("parseRawDate(%q) = %d, %d, %d, but %q parses to %d, %d, %d", raw, mm, dd, yy, canonical, m, d, y)
+		}
+	})
+}
+
 func TestRunCli(t *testing.T) {
 	t.Run("shouldn't crash", func(t *testing.T) {
 		assert.NotPanics(t, func() {
@@ -99,3 +130,95 @@ func TestRunCli(t *testing.T) {
 		})
 	})
 }
+
+func TestConv(t *testing.T) {
+	defer func(w io.Writer) { globalWriter = w }(globalWriter)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"bs to ad", []string{"--from", "bs", "--to", "ad", "08-18-2053"}, "December 3, 1996\n"},
+		{"32nd day", []string{"--from", "bs", "--to", "ad", "02-32-2050"}, "June 14, 1993\n"},
+		{"toad 32nd day", []string{"toad", "02-32-2050"}, "June 14, 1993 Monday\n"},
+		{"ad to bs", []string{"--from", "ad", "--to", "bs", "12-03-1996"}, "मंसिर 18, 2053\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := bytes.NewBuffer([]byte(""))
+			globalWriter = b
+
+			assert.NoError(t, bootstrapCli().Run(append([]string{"nepcal", "conv"}, test.args...)))
+			assert.Equal(t, test.expected, b.String())
+		})
+	}
+}
+
+func TestConvExample(t *testing.T) {
+	tests := []struct {
+		from, to string
+		expected string
+	}{
+		{"ad", "bs", "nepcal conv --from ad --to bs 08-21-1994"},
+		{"BS", "gregorian", "nepcal conv --from BS --to gregorian 05-05-2051"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+			set.String("from", test.from, "")
+			set.String("to", test.to, "")
+
+			from, _ := nepcal.LookupCalendarSystem(test.from)
+			assert.Equal(t, test.expected, convExample(cli.NewContext(nil, set, nil), from))
+		})
+	}
+}
+
+func TestPrintSystemDate(t *testing.T) {
+	b := bytes.NewBuffer([]byte(""))
+
+	y, m, d, err := nepcal.Convert(nepcal.BikramSambat, nepcal.Gregorian, 2053, 8, 18)
+	assert.NoError(t, err)
+
+	printSystemDate(b, nepcal.Gregorian, y, m, d)
+	assert.Equal(t, "December 3, 1996\n", b.String())
+}
+
+func TestParseFuzzyArgs(t *testing.T) {
+	now := time.Date(2024, time.July, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected nepcal.Time
+		ok       bool
+	}{
+		{"keyword", []string{"today"}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), true},
+		{"split phrase", []string{"15", "Shrawan", "2081"}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 15), true},
+		{"offset", []string{"+10d"}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 25), true},
+		{"no args", []string{}, nepcal.Time{}, false},
+		{"gibberish", []string{"someday"}, nepcal.Time{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+			assert.NoError(t, set.Parse(test.args))
+
+			bs, ok := parseFuzzyArgs(cli.NewContext(nil, set, nil), now)
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, bs)
+		})
+	}
+}
+
+func TestASCIIWriter(t *testing.T) {
+	b := bytes.NewBuffer([]byte(""))
+
+	fmt.Fprintln(asciiWriter{b}, nepcal.DateUnchecked(2075, nepcal.Jestha, 3).String())
+	assert.Equal(t, "jeth 3, 2075 bihibar\n", b.String())
+}
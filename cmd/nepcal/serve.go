@@ -0,0 +1,306 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/srishanbhattarai/nepcal/nepcalpb"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// dateJSON is the structured representation of a date in machine readable outputs.
+type dateJSON struct {
+	BS        bsDateJSON  `json:"bs" yaml:"bs"`
+	AD        adDateJSON  `json:"ad" yaml:"ad"`
+	Weekday   weekdayJSON `json:"weekday" yaml:"weekday"`
+	JulianDay int         `json:"julianDay" yaml:"julianDay"`
+}
+
+type bsDateJSON struct {
+	Year      int    `json:"year" yaml:"year"`
+	Month     int    `json:"month" yaml:"month"`
+	Day       int    `json:"day" yaml:"day"`
+	MonthName string `json:"monthName" yaml:"monthName"`
+	Date      string `json:"date" yaml:"date"`
+	Numeral   string `json:"numeral" yaml:"numeral"`
+	Formatted string `json:"formatted" yaml:"formatted"`
+}
+
+type adDateJSON struct {
+	Year      int    `json:"year" yaml:"year"`
+	Month     int    `json:"month" yaml:"month"`
+	Day       int    `json:"day" yaml:"day"`
+	MonthName string `json:"monthName" yaml:"monthName"`
+	Date      string `json:"date" yaml:"date"`
+}
+
+type weekdayJSON struct {
+	Index   int    `json:"index" yaml:"index"`
+	Name    string `json:"name" yaml:"name"`
+	English string `json:"english" yaml:"english"`
+}
+
+// calendarJSON is the structured representation of a B.S. month.
+type calendarJSON struct {
+	Year         int        `json:"year" yaml:"year"`
+	Month        int        `json:"month" yaml:"month"`
+	MonthName    string     `json:"monthName" yaml:"monthName"`
+	NumDays      int        `json:"numDays" yaml:"numDays"`
+	StartWeekday int        `json:"startWeekday" yaml:"startWeekday"`
+	Days         []dateJSON `json:"days" yaml:"days"`
+}
+
+// newDateJSON creates the structured representation of a date.
+func newDateJSON(t nepcal.Time) dateJSON {
+	yy, mm, dd := t.Date()
+	ad := t.Gregorian()
+
+	return dateJSON{
+		BS: bsDateJSON{
+			Year:      yy,
+			Month:     int(mm),
+			Day:       dd,
+			MonthName: mm.Name(),
+			Date:      fmt.Sprintf("%04d-%02d-%02d", yy, mm, dd),
+			Numeral:   fmt.Sprintf("%s-%s-%s", nepcal.Numeral(yy).Pad(4), nepcal.Numeral(mm).Pad(2), nepcal.Numeral(dd).Pad(2)),
+			Formatted: t.String(),
+		},
+		AD: adDateJSON{
+			Year:      ad.Year(),
+			Month:     int(ad.Month()),
+			Day:       ad.Day(),
+			MonthName: ad.Month().String(),
+			Date:      ad.Format("2006-01-02"),
+		},
+		Weekday: weekdayJSON{
+			Index:   int(t.Weekday()),
+			Name:    t.Weekday().Name(),
+			English: time.Weekday(t.Weekday()).String(),
+		},
+		JulianDay: t.JulianDay(),
+	}
+}
+
+// newCalendarJSON creates the structured representation of the month that 't' is in.
+func newCalendarJSON(t nepcal.Time) calendarJSON {
+	yy, mm, _ := t.Date()
+
+	cal := calendarJSON{
+		Year:         yy,
+		Month:        int(mm),
+		MonthName:    mm.Name(),
+		NumDays:      t.NumDaysInMonth(),
+		StartWeekday: int(t.StartWeekday()),
+	}
+
+	for d := 1; d <= cal.NumDays; d++ {
+		cal.Days = append(cal.Days, newDateJSON(nepcal.DateUnchecked(yy, mm, d)))
+	}
+
+	return cal
+}
+
+// server is the HTTP JSON conversion service.
+type server struct {
+	// now returns the current time; overridden in tests.
+	now func() time.Time
+	mux *http.ServeMux
+}
+
+// newServer creates the HTTP handler for the conversion service.
+func newServer(now func() time.Time) *server {
+	s := &server{now: now, mux: http.NewServeMux()}
+
+	s.mux.HandleFunc("/healthz", s.get(s.health))
+	s.mux.HandleFunc("/v1/today", s.get(s.today))
+	s.mux.HandleFunc("/v1/tobs", s.get(s.toBS))
+	s.mux.HandleFunc("/v1/toad", s.get(s.toAD))
+	s.mux.HandleFunc("/v1/calendar/", s.get(s.calendar))
+
+	return s
+}
+
+// ServeHTTP satisfies the http.Handler interface.
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// withGRPC mounts the gRPC Converter service alongside the handler 'h' on the
+// same address. gRPC requests are served over HTTP/2 without TLS (h2c), and
+// every other request is passed on to 'h'.
+func withGRPC(h http.Handler, now func() time.Time) http.Handler {
+	g := grpc.NewServer()
+	nepcalpb.RegisterConverterServer(g, nepcalpb.NewConverterServer(now))
+
+	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			g.ServeHTTP(w, r)
+
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	}), &http2.Server{})
+}
+
+// get restricts a handler to GET and HEAD requests.
+func (s *server) get(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+
+			return
+		}
+
+		h(w, r)
+	}
+}
+
+// GET /healthz
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// GET /v1/today
+//
+// Today is determined in Nepal Time and can be cached until the end of the day.
+func (s *server) today(w http.ResponseWriter, r *http.Request) {
+	now := s.now().In(nepcal.NepalTime)
+
+	bs, err := nepcal.FromGregorian(gregorian(now.Year(), int(now.Month()), now.Day()))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Today's date is out of the supported range")
+
+		return
+	}
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, nepcal.NepalTime)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(midnight.Sub(now).Seconds())))
+	writeJSON(w, http.StatusOK, newDateJSON(bs))
+}
+
+// GET /v1/tobs?date=yyyy-mm-dd
+func (s *server) toBS(w http.ResponseWriter, r *http.Request) {
+	yy, mm, dd, ok := parseISODate(r.URL.Query().Get("date"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Please supply a valid A.D. date in the format yyyy-mm-dd, e.g. ?date=1994-08-21")
+
+		return
+	}
+
+	ad := gregorian(yy, mm, dd)
+	if ad.Day() != dd {
+		writeError(w, http.StatusBadRequest, "The supplied A.D. date does not exist")
+
+		return
+	}
+
+	bs, err := nepcal.FromGregorian(ad)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "The supplied A.D. date is out of the supported range")
+
+		return
+	}
+
+	writeCacheable(w, newDateJSON(bs))
+}
+
+// GET /v1/toad?date=yyyy-mm-dd
+func (s *server) toAD(w http.ResponseWriter, r *http.Request) {
+	yy, mm, dd, ok := parseISODate(r.URL.Query().Get("date"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Please supply a valid B.S. date in the format yyyy-mm-dd, e.g. ?date=2053-08-18")
+
+		return
+	}
+
+	if _, err := nepcal.BikramSambat.JulianDay(yy, mm, dd); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "The supplied B.S. date does not exist or is out of the supported range")
+
+		return
+	}
+
+	writeCacheable(w, newDateJSON(nepcal.DateUnchecked(yy, nepcal.Month(mm), dd)))
+}
+
+// GET /v1/calendar/{year}/{month}
+func (s *server) calendar(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/calendar/"), "/"), "/")
+	if len(parts) != 2 {
+		writeError(w, http.StatusNotFound, "Please use the path /v1/calendar/{year}/{month}")
+
+		return
+	}
+
+	yy, yerr := strconv.Atoi(parts[0])
+	mm, merr := strconv.Atoi(parts[1])
+	if yerr != nil || merr != nil || mm < 1 || mm > 12 {
+		writeError(w, http.StatusBadRequest, "Please supply a valid B.S. year and month (1-12), e.g. /v1/calendar/2081/4")
+
+		return
+	}
+
+	if !nepcal.IsInRangeYear(yy) {
+		writeError(w, http.StatusUnprocessableEntity, "The supplied B.S. year is out of the supported range")
+
+		return
+	}
+
+	writeCacheable(w, newCalendarJSON(nepcal.DateUnchecked(yy, nepcal.Month(mm), 1)))
+}
+
+// writeCacheable writes a response that does not change for the same request,
+// which is the case for all conversions unless the data tables are corrected.
+func writeCacheable(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	writeJSON(w, http.StatusOK, v)
+}
+
+// writeError writes a JSON error response.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// writeJSON writes 'v' as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(v)
+}
+
+// Parse an ISO 8601 style yyyy-mm-dd date. The last parameter is a boolean indicating
+// if the date is valid or not. Days up to 32 are allowed since B.S. months can have
+// 32 days; whether the date exists has to be checked for the respective calendar.
+func parseISODate(rawDate string) (int, int, int, bool) {
+	dateParts := strings.Split(rawDate, "-")
+	if len(dateParts) != 3 || len(dateParts[0]) != 4 {
+		return -1, -1, -1, false
+	}
+
+	var parts [3]int
+	for i, p := range dateParts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return -1, -1, -1, false
+		}
+
+		parts[i] = n
+	}
+
+	yy, mm, dd := parts[0], parts[1], parts[2]
+	if dd < 1 || dd > 32 || mm < 1 || mm > 12 {
+		return -1, -1, -1, false
+	}
+
+	return yy, mm, dd, true
+}
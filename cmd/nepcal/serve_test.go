@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcalpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestServer(t *testing.T) {
+	// 20:00 UTC is already the next day in Nepal.
+	now := func() time.Time { return time.Date(2024, time.July, 29, 20, 0, 0, 0, time.UTC) }
+	srv := httptest.NewServer(newServer(now))
+	defer srv.Close()
+
+	get := func(t *testing.T, path string, status int, v interface{}) *http.Response {
+		res, err := http.Get(srv.URL + path)
+		assert.NoError(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, status, res.StatusCode)
+		assert.Equal(t, "application/json; charset=utf-8", res.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(v))
+
+		return res
+	}
+
+	t.Run("health", func(t *testing.T) {
+		var body map[string]string
+		get(t, "/healthz", http.StatusOK, &body)
+
+		assert.Equal(t, "ok", body["status"])
+	})
+
+	t.Run("today", func(t *testing.T) {
+		var body dateJSON
+		res := get(t, "/v1/today", http.StatusOK, &body)
+
+		assert.Equal(t, "2081-04-15", body.BS.Date)
+		assert.Equal(t, "2024-07-30", body.AD.Date)
+		assert.Equal(t, "public, max-age=80100", res.Header.Get("Cache-Control"))
+	})
+
+	t.Run("tobs", func(t *testing.T) {
+		var body dateJSON
+		res := get(t, "/v1/tobs?date=1994-08-21", http.StatusOK, &body)
+
+		assert.Equal(t, bsDateJSON{
+			Year:      2051,
+			Month:     5,
+			Day:       5,
+			MonthName: "भदौ",
+			Date:      "2051-05-05",
+			Numeral:   "२०५१-०५-०५",
+			Formatted: "भदौ ५, २०५१ आइतबार",
+		}, body.BS)
+		assert.Equal(t, weekdayJSON{0, "आइतबार", "Sunday"}, body.Weekday)
+		assert.Equal(t, "public, max-age=86400", res.Header.Get("Cache-Control"))
+	})
+
+	t.Run("tobs upper bound", func(t *testing.T) {
+		var body dateJSON
+		get(t, "/v1/tobs?date=2044-04-12", http.StatusOK, &body)
+
+		assert.Equal(t, "2100-12-30", body.BS.Date)
+
+		var errBody map[string]string
+		res := get(t, "/v1/tobs?date=2044-04-13", http.StatusUnprocessableEntity, &errBody)
+
+		assert.Equal(t, "The supplied A.D. date is out of the supported range", errBody["error"])
+		assert.Empty(t, res.Header.Get("Cache-Control"))
+	})
+
+	t.Run("toad", func(t *testing.T) {
+		var body dateJSON
+		get(t, "/v1/toad?date=2053-08-18", http.StatusOK, &body)
+
+		assert.Equal(t, adDateJSON{1996, 12, 3, "December", "1996-12-03"}, body.AD)
+	})
+
+	t.Run("calendar", func(t *testing.T) {
+		var body calendarJSON
+		get(t, "/v1/calendar/2075/2", http.StatusOK, &body)
+
+		assert.Equal(t, "जेठ", body.MonthName)
+		assert.Equal(t, 31, body.NumDays)
+		assert.Equal(t, 2, body.StartWeekday)
+		assert.Len(t, body.Days, 31)
+		assert.Equal(t, "2018-05-15", body.Days[0].AD.Date)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		tests := []struct {
+			path   string
+			status int
+		}{
+			{"/v1/tobs", http.StatusBadRequest},
+			{"/v1/tobs?date=08-21-1994", http.StatusBadRequest},
+			{"/v1/tobs?date=2019-02-29", http.StatusBadRequest},
+			{"/v1/tobs?date=2019-08-32", http.StatusBadRequest},
+			{"/v1/tobs?date=1900-01-01", http.StatusUnprocessableEntity},
+			{"/v1/tobs?date=2050-01-01", http.StatusUnprocessableEntity},
+			{"/v1/toad?date=2076-03-32", http.StatusUnprocessableEntity},
+			{"/v1/toad?date=xyz", http.StatusBadRequest},
+			{"/v1/calendar/2075", http.StatusNotFound},
+			{"/v1/calendar/2075/13", http.StatusBadRequest},
+			{"/v1/calendar/1900/1", http.StatusUnprocessableEntity},
+		}
+
+		for _, test := range tests {
+			var body map[string]string
+			get(t, test.path, test.status, &body)
+
+			assert.NotEmpty(t, body["error"], test.path)
+		}
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		res, err := http.Post(srv.URL+"/v1/today", "application/json", nil)
+		assert.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+		assert.Equal(t, "GET, HEAD", res.Header.Get("Allow"))
+	})
+}
+
+func TestParseISODate(t *testing.T) {
+	tests := []struct {
+		raw        string
+		yy, mm, dd int
+		ok         bool
+	}{
+		{"1994-08-21", 1994, 8, 21, true},
+		{"2076-02-32", 2076, 2, 32, true},
+		{"2076-02-33", -1, -1, -1, false},
+		{"2076-13-01", -1, -1, -1, false},
+		{"76-01-01", -1, -1, -1, false},
+		{"2076-01", -1, -1, -1, false},
+		{"2076-aa-01", -1, -1, -1, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.raw, func(t *testing.T) {
+			yy, mm, dd, ok := parseISODate(test.raw)
+
+			assert.Equal(t, test.yy, yy)
+			assert.Equal(t, test.mm, mm)
+			assert.Equal(t, test.dd, dd)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func FuzzParseISODate(f *testing.F) {
+	for _, s := range []string{"1994-08-21", "2076-02-32", "2076-13-01", "76-01-01", "+076-01-01", ""} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		yy, mm, dd, ok := parseISODate(raw)
+		if !ok {
+			return
+		}
+
+		if mm < 1 || mm > 12 || dd < 1 || dd > 32 || yy < 0 || yy > 9999 {
+			t.Fatalf("parseISODate(%q) = %d, %d, %d, which is out of range", raw, yy, mm, dd)
+		}
+
+		// The canonical form of the date parses to the same date.
+		canonical := fmt.Sprintf("%04d-%02d-%02d", yy, mm, dd)
+		if y, m, d, ok := parseISODate(canonical); !ok || y != yy || m != mm || d != dd {
+			t.Fatalf("parseISODate(%q) = %d, %d, %d, but %q parses to %d, %d, %d", raw, yy, mm, dd, canonical, y, m, d)
+		}
+	})
+}
+
+func TestServerGRPC(t *testing.T) {
+	srv := httptest.NewServer(withGRPC(newServer(time.Now), time.Now))
+	defer srv.Close()
+
+	conn, err := grpc.Dial(srv.Listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	t.Run("grpc", func(t *testing.T) {
+		client := nepcalpb.NewConverterClient(conn)
+
+		d, err := client.ToAD(context.Background(), &nepcalpb.ToADRequest{Date: &nepcalpb.BSDate{Year: 2053, Month: 8, Day: 18}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1996), d.Ad.Year)
+		assert.Equal(t, int32(12), d.Ad.Month)
+		assert.Equal(t, int32(3), d.Ad.Day)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		res, err := http.Get(srv.URL + "/healthz")
+		assert.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	})
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+)
+
+// Output modes of the 'status' command.
+const (
+	statusPlain  = "plain"
+	statusI3bar  = "i3bar"
+	statusWaybar = "waybar"
+)
+
+// How often the i3bar stream checks whether the date has changed.
+const i3barInterval = time.Minute
+
+// Flags for the 'status' command.
+func statusFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Layout or template of the date, as accepted by 'nepcal date --format'",
+			Value: nepcal.NepaliDate,
+		},
+		&cli.StringFlag{
+			Name:  "mode",
+			Usage: "Output mode: plain, i3bar (a JSON protocol stream for status_command) or waybar (JSON for a custom module)",
+			Value: statusPlain,
+		},
+		&cli.BoolFlag{
+			Name:  "cache",
+			Usage: "Reuse the output computed earlier today, for prompts and status bars that run nepcal often",
+		},
+	}
+}
+
+// Shows today's date for use in shell prompts and status bars.
+func (nepcalCli) showStatus(c *cli.Context) error {
+	format, mode := c.String("format"), c.String("mode")
+
+	var err error
+	switch mode {
+	case statusI3bar:
+		err = streamI3bar(output(c, globalWriter), format, time.Now, time.NewTicker(i3barInterval).C)
+	case statusPlain, statusWaybar:
+		err = printStatus(output(c, globalWriter), format, mode, time.Now(), c.Bool("cache"))
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown mode %q. Supported modes: %s, %s, %s\n", mode, statusPlain, statusI3bar, statusWaybar)
+
+		return cli.Exit("", 1)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
+
+// Prints the status for the day of 'now' in the plain or waybar 'mode'. When
+// 'cached' is set, the output is read from the cache if it was computed on the
+// same day, and is written into the cache otherwise.
+func printStatus(w io.Writer, format, mode string, now time.Time, cached bool) error {
+	if !cached {
+		out, err := statusOutput(format, mode, now)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, out)
+
+		return err
+	}
+
+	path, err := statusCachePath(format, mode)
+	if err != nil {
+		return err
+	}
+
+	day := now.Format("2006-01-02")
+	if out, ok := readStatusCache(path, day); ok {
+		_, err = io.WriteString(w, out)
+
+		return err
+	}
+
+	out, err := statusOutput(format, mode, now)
+	if err != nil {
+		return err
+	}
+
+	// Failing to cache the output only means that it is computed again next time.
+	writeStatusCache(path, day, out)
+
+	_, err = io.WriteString(w, out)
+
+	return err
+}
+
+// Computes the status for the day of 'now' in the plain or waybar 'mode'.
+func statusOutput(format, mode string, now time.Time) (string, error) {
+	t, err := statusDate(now)
+	if err != nil {
+		return "", err
+	}
+
+	text, err := formatDate(format, t, nepcal.BikramSambat)
+	if err != nil {
+		return "", err
+	}
+
+	if mode == statusPlain {
+		return text + "\n", nil
+	}
+
+	b, err := json.Marshal(waybarStatus{
+		Text:    text,
+		Tooltip: statusTooltip(t),
+		Class:   strings.ToLower(time.Weekday(t.Weekday()).String()),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b) + "\n", nil
+}
+
+// waybarStatus is the output of a waybar custom module with "return-type": "json".
+type waybarStatus struct {
+	Text    string `json:"text"`
+	Tooltip string `json:"tooltip"`
+	Class   string `json:"class"`
+}
+
+// i3barBlock is a single block of the i3bar JSON protocol.
+type i3barBlock struct {
+	Name      string `json:"name"`
+	FullText  string `json:"full_text"`
+	ShortText string `json:"short_text"`
+}
+
+// Writes today's date into 'w' as an i3bar JSON protocol stream. A new status
+// line is written whenever the date changes, which is checked on every tick.
+// The stream ends when 'ticks' is closed.
+func streamI3bar(w io.Writer, format string, now func() time.Time, ticks <-chan time.Time) error {
+	if _, err := io.WriteString(w, "{\"version\":1}\n[\n"); err != nil {
+		return err
+	}
+
+	// Every status line after the first is preceded by a comma, as the stream
+	// is an infinite JSON array.
+	last, sep := "", ""
+	for {
+		t, err := statusDate(now())
+		if err != nil {
+			return err
+		}
+
+		text, err := formatDate(format, t, nepcal.BikramSambat)
+		if err != nil {
+			return err
+		}
+
+		if text != last {
+			b, err := json.Marshal([]i3barBlock{{Name: "nepcal", FullText: text, ShortText: t.Format(nepcal.ISODate)}})
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, "%s%s\n", sep, b); err != nil {
+				return err
+			}
+
+			last, sep = text, ","
+		}
+
+		if _, ok := <-ticks; !ok {
+			return nil
+		}
+	}
+}
+
+// statusDate returns the B.S. date on the day of 'now', in its own time zone.
+func statusDate(now time.Time) (nepcal.Time, error) {
+	return nepcal.FromGregorian(gregorian(now.Year(), int(now.Month()), now.Day()))
+}
+
+// statusTooltip describes the date 't' in both calendars.
+func statusTooltip(t nepcal.Time) string {
+	return t.String() + "\n" + t.Gregorian().Format("Monday, January 2, 2006")
+}
+
+// Returns the path of the cache file for the status in the 'format' and 'mode'.
+func statusCachePath(format, mode string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	h := fnv.New64a()
+	io.WriteString(h, mode+"\x00"+format)
+
+	return filepath.Join(dir, "nepcal", fmt.Sprintf("status-%x", h.Sum64())), nil
+}
+
+// Reads the cached status computed on 'day'. The first line of the cache file
+// is the day it was computed on, followed by the output.
+func readStatusCache(path, day string) (string, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+
+	header := []byte(day + "\n")
+	if !bytes.HasPrefix(b, header) {
+		return "", false
+	}
+
+	return string(b[len(header):]), true
+}
+
+// Writes the status computed on 'day' into the cache.
+func writeStatusCache(path, day, out string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Write into a temporary file first, so concurrent readers never see a partial file.
+	tmp := fmt.Sprintf("%s.%d", path, os.Getpid())
+	if err := os.WriteFile(tmp, []byte(day+"\n"+out), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
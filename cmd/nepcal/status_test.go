@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusOutput(t *testing.T) {
+	now := time.Date(2024, time.July, 30, 23, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		format   string
+		mode     string
+		expected string
+	}{
+		{"plain", nepcal.NepaliDate, statusPlain, "साउन १५, २०८१ मंगलबार\n"},
+		{"plain template", "{{.BS.Date}} {{.Weekday.English}}", statusPlain, "2081-04-15 Tuesday\n"},
+		{
+			"waybar", "२ बैशाख", statusWaybar,
+			`{"text":"१५ साउन","tooltip":"साउन १५, २०८१ मंगलबार\nTuesday, July 30, 2024","class":"tuesday"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := statusOutput(test.format, test.mode, now)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := statusOutput(nepcal.NepaliDate, statusPlain, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.Error(t, err)
+	})
+}
+
+func TestPrintStatusCached(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	day := time.Date(2024, time.July, 30, 8, 0, 0, 0, time.UTC)
+
+	b := bytes.NewBuffer([]byte(""))
+	assert.NoError(t, printStatus(b, nepcal.ISODate, statusPlain, day, true))
+	assert.Equal(t, "2081-04-15\n", b.String())
+
+	path, err := statusCachePath(nepcal.ISODate, statusPlain)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(path, dir))
+
+	// The cache is used for the rest of the day, regardless of its contents.
+	assert.NoError(t, os.WriteFile(path, []byte("2024-07-30\ncached\n"), 0o644))
+
+	b.Reset()
+	assert.NoError(t, printStatus(b, nepcal.ISODate, statusPlain, day.Add(12*time.Hour), true))
+	assert.Equal(t, "cached\n", b.String())
+
+	// But not on the next day.
+	b.Reset()
+	assert.NoError(t, printStatus(b, nepcal.ISODate, statusPlain, day.Add(24*time.Hour), true))
+	assert.Equal(t, "2081-04-16\n", b.String())
+
+	cache, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-07-31\n2081-04-16\n", string(cache))
+
+	// Other formats and modes are cached separately.
+	other, err := statusCachePath(nepcal.ISODate, statusWaybar)
+	assert.NoError(t, err)
+	assert.NotEqual(t, path, other)
+
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestStreamI3bar(t *testing.T) {
+	times := []time.Time{
+		time.Date(2024, time.July, 30, 23, 58, 0, 0, time.UTC),
+		time.Date(2024, time.July, 30, 23, 59, 0, 0, time.UTC),
+		time.Date(2024, time.July, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	i := 0
+	now := func() time.Time {
+		t := times[i]
+		i++
+
+		return t
+	}
+
+	ticks := make(chan time.Time, 2)
+	ticks <- time.Time{}
+	ticks <- time.Time{}
+	close(ticks)
+
+	b := bytes.NewBuffer([]byte(""))
+	assert.NoError(t, streamI3bar(b, "२ बैशाख", now, ticks))
+
+	assert.Equal(t, strings.Join([]string{
+		`{"version":1}`,
+		`[`,
+		`[{"name":"nepcal","full_text":"१५ साउन","short_text":"2081-04-15"}]`,
+		`,[{"name":"nepcal","full_text":"१६ साउन","short_text":"2081-04-16"}]`,
+		``,
+	}, "\n"), b.String())
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
+)
+
+// ANSI escape sequences used by the interactive calendar.
+const (
+	ansiReverse    = "\x1b[7m"
+	ansiBold       = "\x1b[1m"
+	ansiReset      = "\x1b[0m"
+	ansiClear      = "\x1b[H\x1b[2J"
+	ansiAltScreen  = "\x1b[?1049h\x1b[?25l"
+	ansiMainScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+// Number of spaces between the calendar and the panel describing the selected day.
+const tuiPanelSpacing = 4
+
+// Runs the interactive calendar in the terminal until it is quit.
+func (nepcalCli) showTUI(c *cli.Context) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		fmt.Fprintln(os.Stderr, "The interactive calendar needs to be run in a terminal.")
+
+		return cli.Exit("", 1)
+	}
+
+	ev := events{}
+	if path := c.String("events"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return cli.Exit("", 1)
+		}
+		defer f.Close()
+
+		if ev, err = loadEvents(f); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return cli.Exit("", 1)
+		}
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return cli.Exit("", 1)
+	}
+	defer term.Restore(fd, state)
+
+	w := bufio.NewWriter(os.Stdout)
+	fmt.Fprint(w, ansiAltScreen)
+	defer func() {
+		fmt.Fprint(w, ansiMainScreen)
+		w.Flush()
+	}()
+
+	ui := newTUI(nepcal.FromGregorianUnchecked(time.Now()), ev)
+	buf := make([]byte, 16)
+
+	for {
+		fmt.Fprint(w, ansiClear)
+		ui.render(w)
+		w.Flush()
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return nil
+		}
+
+		for _, k := range parseKeys(buf[:n]) {
+			if ui.handle(k) {
+				return nil
+			}
+		}
+	}
+}
+
+// key is a single key press in the interactive calendar. Printable characters
+// are represented by their rune, and special keys by the negative constants below.
+type key rune
+
+// Special keys.
+const (
+	keyUp key = -(iota + 1)
+	keyDown
+	keyLeft
+	keyRight
+	keyPageUp
+	keyPageDown
+	keyEnter
+	keyBackspace
+	keyEscape
+	keyInterrupt
+)
+
+// escapeSequences are the keys sent as escape sequences, without the leading "\x1b[".
+var escapeSequences = map[string]key{
+	"A":  keyUp,
+	"B":  keyDown,
+	"C":  keyRight,
+	"D":  keyLeft,
+	"5~": keyPageUp,
+	"6~": keyPageDown,
+}
+
+// parseKeys parses the bytes read from a terminal in raw mode into key presses.
+func parseKeys(b []byte) []key {
+	var keys []key
+
+	for len(b) > 0 {
+		switch {
+		case b[0] == 0x1b && len(b) >= 3 && b[1] == '[':
+			matched := false
+
+			for s, k := range escapeSequences {
+				if strings.HasPrefix(string(b[2:]), s) {
+					keys = append(keys, k)
+					b = b[2+len(s):]
+					matched = true
+
+					break
+				}
+			}
+
+			if !matched {
+				// Skip unknown escape sequences entirely.
+				b = b[:0]
+			}
+
+			continue
+		case b[0] == 0x1b:
+			keys = append(keys, keyEscape)
+		case b[0] == '\r' || b[0] == '\n':
+			keys = append(keys, keyEnter)
+		case b[0] == 0x7f || b[0] == 0x08:
+			keys = append(keys, keyBackspace)
+		case b[0] == 0x03 || b[0] == 0x04:
+			keys = append(keys, keyInterrupt)
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, key(r))
+			b = b[size:]
+
+			continue
+		}
+
+		b = b[1:]
+	}
+
+	return keys
+}
+
+// events are the optional details shown for each day, keyed by the B.S.
+// date in the yyyy-mm-dd format. nepcal does not ship with any tithi or
+// holiday data, so these are loaded from a file provided by the user.
+type events map[string]dayEvents
+
+// dayEvents are the details of a single day.
+type dayEvents struct {
+	Tithi    string   `json:"tithi"`
+	Holidays []string `json:"holidays"`
+}
+
+// loadEvents reads events from a JSON object mapping B.S. dates to their
+// details, e.g. {"2081-06-27": {"tithi": "दशमी", "holidays": ["विजया दशमी"]}}.
+func loadEvents(r io.Reader) (events, error) {
+	ev := events{}
+	if err := json.NewDecoder(r).Decode(&ev); err != nil {
+		return nil, fmt.Errorf("unable to read events: %w", err)
+	}
+
+	for date := range ev {
+		yy, mm, dd, ok := parseISODate(date)
+		if !ok {
+			return nil, fmt.Errorf("invalid date %q in events, expected a B.S. date in the format yyyy-mm-dd", date)
+		}
+
+		if _, err := nepcal.Date(yy, nepcal.Month(mm), dd); err != nil {
+			return nil, fmt.Errorf("the date %q in events does not exist or is out of the supported range", date)
+		}
+	}
+
+	return ev, nil
+}
+
+// tui is the state of the interactive calendar.
+type tui struct {
+	selected, today nepcal.Time
+	events          events
+
+	// The date being typed after pressing 'g', or nil when not typing.
+	input *string
+
+	// A message shown below the calendar, such as an invalid date.
+	message string
+}
+
+func newTUI(today nepcal.Time, ev events) *tui {
+	return &tui{selected: today, today: today, events: ev}
+}
+
+// handle updates the state for a key press, and reports if the calendar should quit.
+func (u *tui) handle(k key) bool {
+	if k == keyInterrupt {
+		return true
+	}
+
+	if u.input != nil {
+		u.handleInput(k)
+
+		return false
+	}
+
+	u.message = ""
+
+	switch k {
+	case 'q', keyEscape:
+		return true
+	case keyLeft, 'h':
+		u.move(u.selected.AddDays(-1))
+	case keyRight, 'l':
+		u.move(u.selected.AddDays(1))
+	case keyUp, 'k':
+		u.move(u.selected.AddDays(-7))
+	case keyDown, 'j':
+		u.move(u.selected.AddDays(7))
+	case keyPageUp, 'p', '[':
+		u.move(addMonths(u.selected, -1))
+	case keyPageDown, 'n', ']':
+		u.move(addMonths(u.selected, 1))
+	case 't':
+		u.selected = u.today
+	case 'g':
+		input := ""
+		u.input = &input
+	}
+
+	return false
+}
+
+// handleInput updates the date being typed after pressing 'g'.
+func (u *tui) handleInput(k key) {
+	switch {
+	case k == keyEscape:
+		u.input = nil
+	case k == keyEnter:
+		t, err := parseGoTo(*u.input, u.selected)
+		if err != nil {
+			u.message = fmt.Sprintf("Unable to understand the date %q", *u.input)
+		} else {
+			u.selected = t
+		}
+
+		u.input = nil
+	case k == keyBackspace:
+		if r := []rune(*u.input); len(r) > 0 {
+			*u.input = string(r[:len(r)-1])
+		}
+	case k >= ' ':
+		*u.input += string(rune(k))
+	}
+}
+
+// parseGoTo parses the B.S. date typed after pressing 'g', either in the
+// mm-dd-yyyy format of the CLI, the yyyy-mm-dd format of the events file, or
+// as a phrase understood by nepcal.ParseFuzzy relative to 'ref'.
+func parseGoTo(s string, ref nepcal.Time) (nepcal.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if mm, dd, yy, ok := parseRawDateUpTo(s, maxDaysInMonth); ok {
+		return nepcal.Date(yy, nepcal.Month(mm), dd)
+	}
+
+	if yy, mm, dd, ok := parseISODate(s); ok {
+		return nepcal.Date(yy, nepcal.Month(mm), dd)
+	}
+
+	return nepcal.ParseFuzzy(s, ref)
+}
+
+// move selects the date 't' unless it is out of the supported range.
+func (u *tui) move(t nepcal.Time, err error) {
+	if err != nil {
+		u.message = "The date is out of the supported range"
+
+		return
+	}
+
+	u.selected = t
+}
+
+// addMonths returns the same day 'n' months after 't', clamped to the number
+// of days in that month.
+func addMonths(t nepcal.Time, n int) (nepcal.Time, error) {
+	yy, mm, dd := t.Date()
+
+	months := yy*12 + int(mm) - 1 + n
+	yy, mm = months/12, nepcal.Month(months%12+1)
+
+	numDays, err := mm.NumDays(yy)
+	if err != nil {
+		return nepcal.Time{}, err
+	}
+
+	if dd > numDays {
+		dd = numDays
+	}
+
+	return nepcal.Date(yy, mm, dd)
+}
+
+// render draws the calendar of the selected month with the selected day
+// highlighted, and the details of the selected day in a panel to its right.
+func (u *tui) render(w io.Writer) {
+	var cal strings.Builder
+	io.Copy(&cal, u.selected.Calendar())
+
+	calLines := strings.Split(strings.TrimRight(cal.String(), "\n"), "\n")
+	panel := u.panel()
+
+	width := 0
+	for _, line := range calLines {
+		if n := utf8.RuneCountInString(line); n > width {
+			width = n
+		}
+	}
+
+	for i := 0; i < len(calLines) || i < len(panel); i++ {
+		line := ""
+		if i < len(calLines) {
+			line = calLines[i]
+		}
+
+		padding := strings.Repeat(" ", width-utf8.RuneCountInString(line)+tuiPanelSpacing)
+
+		// The first two lines are the month header and the weekday names.
+		if i >= 2 {
+			line = u.highlight(line)
+		}
+
+		if i < len(panel) {
+			line += padding + panel[i]
+		}
+
+		fmt.Fprint(w, strings.TrimRight(line, " ")+"\r\n")
+	}
+
+	fmt.Fprint(w, "\r\n")
+
+	switch {
+	case u.input != nil:
+		fmt.Fprintf(w, "Go to: %s\r\n", *u.input)
+	case u.message != "":
+		fmt.Fprintf(w, "%s\r\n", u.message)
+	default:
+		fmt.Fprint(w, "←↓↑→ day/week  [ ] month  t today  g go to date  q quit\r\n")
+	}
+}
+
+// highlight marks the selected day, and today's date if it is in the same
+// month, within a row of the calendar.
+func (u *tui) highlight(line string) string {
+	selected := nepcal.Numeral(u.selected.Day()).String()
+
+	today := ""
+	if yy, mm, _ := u.selected.Date(); yy == u.today.Year() && mm == u.today.Month() {
+		today = nepcal.Numeral(u.today.Day()).String()
+	}
+
+	cells := strings.Split(line, " ")
+	for i, cell := range cells {
+		switch cell {
+		case selected:
+			cells[i] = ansiReverse + cell + ansiReset
+		case today:
+			if today == "" {
+				continue
+			}
+
+			cells[i] = ansiBold + cell + ansiReset
+		}
+	}
+
+	return strings.Join(cells, " ")
+}
+
+// panel returns the lines describing the selected day.
+func (u *tui) panel() []string {
+	ad := u.selected.Gregorian()
+
+	lines := []string{
+		u.selected.String(),
+		ad.Format("Monday, January 2, 2006"),
+		"",
+	}
+
+	day, ok := u.events[u.selected.Format(nepcal.ISODate)]
+
+	tithi := "-"
+	if ok && day.Tithi != "" {
+		tithi = day.Tithi
+	}
+	lines = append(lines, "तिथि: "+tithi)
+
+	if !ok || len(day.Holidays) == 0 {
+		return append(lines, "बिदा: -")
+	}
+
+	lines = append(lines, "बिदा:")
+	for _, h := range day.Holidays {
+		lines = append(lines, "  "+h)
+	}
+
+	return lines
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []key
+	}{
+		{"arrows", "\x1b[A\x1b[B\x1b[C\x1b[D", []key{keyUp, keyDown, keyRight, keyLeft}},
+		{"pages", "\x1b[5~\x1b[6~", []key{keyPageUp, keyPageDown}},
+		{"characters", "gक\r", []key{'g', 'क', keyEnter}},
+		{"control", "\x1b\x7f\x03", []key{keyEscape, keyBackspace, keyInterrupt}},
+		{"unknown sequence", "\x1b[15~", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseKeys([]byte(test.input)))
+		})
+	}
+}
+
+func TestTUINavigation(t *testing.T) {
+	today := nepcal.DateUnchecked(2081, nepcal.Shrawan, 32)
+
+	tests := []struct {
+		name     string
+		keys     []key
+		expected nepcal.Time
+	}{
+		{"next day", []key{keyRight}, nepcal.DateUnchecked(2081, nepcal.Bhadra, 1)},
+		{"previous week", []key{keyUp}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 25)},
+		{"next month clamps the day", []key{keyPageDown}, nepcal.DateUnchecked(2081, nepcal.Bhadra, 31)},
+		{"previous months", []key{'[', '['}, nepcal.DateUnchecked(2081, nepcal.Jestha, 31)},
+		{"back to today", []key{keyDown, keyDown, 't'}, today},
+		{"go to date", append(keys("g15 Baisakh 2082"), keyEnter), nepcal.DateUnchecked(2082, nepcal.Baisakh, 15)},
+		{"go to mm-dd-yyyy", append(keys("g04-32-2081"), keyEnter), nepcal.DateUnchecked(2081, nepcal.Shrawan, 32)},
+		{"go to yyyy-mm-dd", append(keys("g2082-01-15"), keyEnter), nepcal.DateUnchecked(2082, nepcal.Baisakh, 15)},
+		{"go to date with corrections", append(keys("gtoday+\x7f"), keyEnter), today},
+		{"cancelled go to", append(keys("g15 Baisakh 2082"), keyEscape), today},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ui := newTUI(today, nil)
+			for _, k := range test.keys {
+				assert.False(t, ui.handle(k))
+			}
+
+			assert.Equal(t, test.expected, ui.selected)
+		})
+	}
+
+	t.Run("invalid date", func(t *testing.T) {
+		ui := newTUI(today, nil)
+		for _, k := range append(keys("gsomeday"), keyEnter) {
+			ui.handle(k)
+		}
+
+		assert.Equal(t, today, ui.selected)
+		assert.Equal(t, `Unable to understand the date "someday"`, ui.message)
+
+		ui = newTUI(today, nil)
+		for _, k := range append(keys("g2081-01-32"), keyEnter) {
+			ui.handle(k)
+		}
+
+		assert.Equal(t, today, ui.selected)
+		assert.Equal(t, `Unable to understand the date "2081-01-32"`, ui.message)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		ui := newTUI(nepcal.DateUnchecked(1975, nepcal.Baisakh, 1), nil)
+		ui.handle(keyPageUp)
+
+		assert.Equal(t, nepcal.DateUnchecked(1975, nepcal.Baisakh, 1), ui.selected)
+		assert.Equal(t, "The date is out of the supported range", ui.message)
+	})
+
+	t.Run("quit", func(t *testing.T) {
+		assert.True(t, newTUI(today, nil).handle('q'))
+		assert.True(t, newTUI(today, nil).handle(keyInterrupt))
+	})
+}
+
+func TestTUIRender(t *testing.T) {
+	ev, err := loadEvents(strings.NewReader(`{"2081-06-27": {"tithi": "दशमी", "holidays": ["विजया दशमी"]}}`))
+	assert.NoError(t, err)
+
+	ui := newTUI(nepcal.DateUnchecked(2081, nepcal.Ashoj, 25), ev)
+	ui.handle(keyRight)
+	ui.handle(keyRight)
+
+	b := bytes.NewBuffer([]byte(""))
+	ui.render(b)
+
+	out := b.String()
+	assert.Contains(t, out, ansiReverse+"२७"+ansiReset)
+	assert.Contains(t, out, ansiBold+"२५"+ansiReset)
+	assert.Contains(t, out, "असोज २७, २०८१ आइतबार")
+	assert.Contains(t, out, "Sunday, October 13, 2024")
+	assert.Contains(t, out, "तिथि: दशमी")
+	assert.Contains(t, out, "  विजया दशमी")
+
+	ui.handle(keyRight)
+	b.Reset()
+	ui.render(b)
+	assert.Contains(t, b.String(), "तिथि: -")
+	assert.Contains(t, b.String(), "बिदा: -")
+}
+
+func TestLoadEvents(t *testing.T) {
+	_, err := loadEvents(strings.NewReader(`{"2081-13-01": {}}`))
+	assert.Error(t, err)
+
+	_, err = loadEvents(strings.NewReader(`{"2081-04-33": {}}`))
+	assert.Error(t, err)
+
+	_, err = loadEvents(strings.NewReader(`[]`))
+	assert.Error(t, err)
+}
+
+// keys converts a string into the key presses typing it.
+func keys(s string) []key {
+	return parseKeys([]byte(s))
+}
@@ -0,0 +1,189 @@
+// Package compliance generates the statutory tax deadlines of the Inland
+// Revenue Department (IRD) of Nepal, which are defined on the B.S. calendar.
+//
+// Nepal's fiscal year starts on Shrawan 1 and ends on the last day of Ashar of
+// the following B.S. year. Fiscal years are identified by the year they start
+// in, i.e. 2081 is the fiscal year 2081/82.
+package compliance
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// ErrInvalidKind is the error returned when a kind name can not be parsed.
+var ErrInvalidKind = errors.New("Unable to parse the provided kind")
+
+// Kind is the kind of obligation that a deadline is for.
+type Kind int
+
+// Kinds of deadlines.
+const (
+	// VAT returns and payments are due within 25 days of the end of each month,
+	// as per section 19 of the Value Added Tax Act, 2052.
+	VAT Kind = iota + 1
+
+	// Tax deducted at source (TDS) is to be deposited, along with its return,
+	// within 25 days of the end of the month that it was deducted in, as per
+	// section 90 of the Income Tax Act, 2058.
+	TDS
+
+	// Advance tax is paid in instalments of 40%, 70% and 100% of the estimated
+	// tax by the end of Poush, Chaitra and Ashar, as per section 94 of the
+	// Income Tax Act, 2058.
+	AdvanceTax
+
+	// The income tax return is due within three months of the end of the fiscal
+	// year, i.e. by the end of Ashoj, as per section 96 of the Income Tax Act, 2058.
+	IncomeTaxReturn
+)
+
+// kindNames are the names of the kinds, as used in descriptions and encodings.
+var kindNames = map[Kind]string{
+	VAT:             "VAT",
+	TDS:             "TDS",
+	AdvanceTax:      "Advance tax",
+	IncomeTaxReturn: "Income tax return",
+}
+
+// String implements the Stringer interface for Kind.
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The kind is
+// encoded as its name, e.g. "VAT".
+func (k Kind) MarshalText() ([]byte, error) {
+	name, ok := kindNames[k]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %d", k)
+	}
+
+	return []byte(name), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The name
+// of the kind is matched case-insensitively, and an ErrInvalidKind is
+// returned if there is no such kind.
+func (k *Kind) UnmarshalText(text []byte) error {
+	for kind, name := range kindNames {
+		if strings.EqualFold(strings.TrimSpace(string(text)), name) {
+			*k = kind
+
+			return nil
+		}
+	}
+
+	return ErrInvalidKind
+}
+
+// Deadline is the last date to fulfil an obligation.
+type Deadline struct {
+	Date        nepcal.Time
+	Kind        Kind
+	Description string
+}
+
+// FiscalYear returns the fiscal year that 't' is in.
+func FiscalYear(t nepcal.Time) int {
+	if t.Month() < nepcal.Shrawan {
+		return t.Year() - 1
+	}
+
+	return t.Year()
+}
+
+// Deadlines returns the deadlines for the obligations of the fiscal year 'fy',
+// in order of their dates. The deadlines for the last months of the fiscal year
+// and the income tax return fall in the next fiscal year, and the VAT and TDS
+// deadlines in Shrawan of 'fy' are for the previous fiscal year and therefore
+// not included.
+//
+// The deadlines are as defined in the Acts; deadlines that fall on public
+// holidays, which IRD usually extends to the next working day, are not moved.
+// An ErrOutOfBounds is returned if any of the deadlines is outside the
+// supported date range.
+func Deadlines(fy int) ([]Deadline, error) {
+	if !nepcal.IsInRangeYear(fy) || !nepcal.IsInRangeYear(fy+1) {
+		return nil, nepcal.ErrOutOfBounds
+	}
+
+	var deadlines []Deadline
+
+	// VAT and TDS for each month, due on the 25th of the next month.
+	for i := 0; i < 12; i++ {
+		y, m := monthOf(fy, i)
+		dueY, dueM := monthOf(fy, i+1)
+		due := nepcal.DateUnchecked(dueY, dueM, 25)
+		period := fmt.Sprintf("%s %d", romanName(m), y)
+
+		deadlines = append(deadlines,
+			Deadline{due, VAT, "VAT return and payment for " + period},
+			Deadline{due, TDS, "TDS deposit and return for " + period},
+		)
+	}
+
+	// Advance tax instalments, due on the last day of Poush, Chaitra and Ashar.
+	for _, instalment := range []struct {
+		month   nepcal.Month
+		percent int
+		ordinal string
+	}{
+		{nepcal.Poush, 40, "First"},
+		{nepcal.Chaitra, 70, "Second"},
+		{nepcal.Ashar, 100, "Third"},
+	} {
+		y := fy
+		if instalment.month < nepcal.Shrawan {
+			y++
+		}
+
+		deadlines = append(deadlines, Deadline{
+			lastDay(y, instalment.month),
+			AdvanceTax,
+			fmt.Sprintf("%s advance tax instalment, %d%% of the estimated tax for FY %s", instalment.ordinal, instalment.percent, fiscalYearName(fy)),
+		})
+	}
+
+	deadlines = append(deadlines, Deadline{
+		lastDay(fy+1, nepcal.Ashoj),
+		IncomeTaxReturn,
+		"Income tax return for FY " + fiscalYearName(fy),
+	})
+
+	sort.SliceStable(deadlines, func(i, j int) bool {
+		return deadlines[j].Date.After(deadlines[i].Date)
+	})
+
+	return deadlines, nil
+}
+
+// monthOf returns the year and month of the i'th (0-indexed) month of the
+// fiscal year 'fy'; 'i' may be past the end of the fiscal year.
+func monthOf(fy, i int) (int, nepcal.Month) {
+	n := int(nepcal.Shrawan) - 1 + i
+
+	return fy + n/12, nepcal.Month(n%12 + 1)
+}
+
+// lastDay returns the last day of the month 'm' of the year 'y', which must be in range.
+func lastDay(y int, m nepcal.Month) nepcal.Time {
+	return nepcal.DateUnchecked(y, m, nepcal.DateUnchecked(y, m, 1).NumDaysInMonth())
+}
+
+// fiscalYearName returns the name of the fiscal year 'fy', e.g. "2081/82".
+func fiscalYearName(fy int) string {
+	return fmt.Sprintf("%d/%02d", fy, (fy+1)%100)
+}
+
+// romanName returns the romanised name of the month, e.g. "Shrawan".
+func romanName(m nepcal.Month) string {
+	// Invariant: the month is always valid.
+	name, _ := m.MarshalText()
+
+	return string(name)
+}
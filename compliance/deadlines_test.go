@@ -0,0 +1,97 @@
+package compliance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadlines(t *testing.T) {
+	deadlines, err := Deadlines(2081)
+	assert.NoError(t, err)
+	assert.Len(t, deadlines, 28)
+
+	// The deadlines are in order of their dates.
+	for i := 1; i < len(deadlines); i++ {
+		assert.False(t, deadlines[i-1].Date.After(deadlines[i].Date), deadlines[i].Description)
+	}
+
+	tests := []struct {
+		date     nepcal.Time
+		kind     Kind
+		expected string
+	}{
+		{nepcal.DateUnchecked(2081, nepcal.Bhadra, 25), VAT, "VAT return and payment for Shrawan 2081"},
+		{nepcal.DateUnchecked(2081, nepcal.Bhadra, 25), TDS, "TDS deposit and return for Shrawan 2081"},
+		{nepcal.DateUnchecked(2081, nepcal.Poush, 25), VAT, "VAT return and payment for Mangshir 2081"},
+		{nepcal.DateUnchecked(2081, nepcal.Poush, 29), AdvanceTax, "First advance tax instalment, 40% of the estimated tax for FY 2081/82"},
+		{nepcal.DateUnchecked(2081, nepcal.Chaitra, 31), AdvanceTax, "Second advance tax instalment, 70% of the estimated tax for FY 2081/82"},
+		{nepcal.DateUnchecked(2082, nepcal.Baisakh, 25), VAT, "VAT return and payment for Chaitra 2081"},
+		{nepcal.DateUnchecked(2082, nepcal.Ashar, 25), VAT, "VAT return and payment for Jestha 2082"},
+		{nepcal.DateUnchecked(2082, nepcal.Ashar, 32), AdvanceTax, "Third advance tax instalment, 100% of the estimated tax for FY 2081/82"},
+		{nepcal.DateUnchecked(2082, nepcal.Ashar, 25), TDS, "TDS deposit and return for Jestha 2082"},
+		{nepcal.DateUnchecked(2082, nepcal.Ashoj, 31), IncomeTaxReturn, "Income tax return for FY 2081/82"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			for _, d := range deadlines {
+				if d.Description == test.expected {
+					assert.Equal(t, test.date, d.Date)
+					assert.Equal(t, test.kind, d.Kind)
+
+					return
+				}
+			}
+
+			t.Errorf("no deadline %q", test.expected)
+		})
+	}
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, err := Deadlines(2100)
+		assert.Equal(t, nepcal.ErrOutOfBounds, err)
+
+		_, err = Deadlines(1974)
+		assert.Equal(t, nepcal.ErrOutOfBounds, err)
+	})
+}
+
+func TestFiscalYear(t *testing.T) {
+	tests := []struct {
+		t        nepcal.Time
+		expected int
+	}{
+		{nepcal.DateUnchecked(2081, nepcal.Shrawan, 1), 2081},
+		{nepcal.DateUnchecked(2081, nepcal.Chaitra, 31), 2081},
+		{nepcal.DateUnchecked(2082, nepcal.Ashar, 32), 2081},
+		{nepcal.DateUnchecked(2082, nepcal.Baisakh, 1), 2081},
+	}
+
+	for _, test := range tests {
+		t.Run(test.t.Format(nepcal.ISODate), func(t *testing.T) {
+			assert.Equal(t, test.expected, FiscalYear(test.t))
+		})
+	}
+}
+
+func TestKindMarshalText(t *testing.T) {
+	b, err := json.Marshal([]Kind{VAT, TDS, AdvanceTax, IncomeTaxReturn})
+	assert.NoError(t, err)
+	assert.Equal(t, `["VAT","TDS","Advance tax","Income tax return"]`, string(b))
+
+	_, err = json.Marshal(Kind(0))
+	assert.Error(t, err)
+}
+
+func TestKindUnmarshalText(t *testing.T) {
+	var kinds []Kind
+	assert.NoError(t, json.Unmarshal([]byte(`["VAT","tds","Advance tax","INCOME TAX RETURN"]`), &kinds))
+	assert.Equal(t, []Kind{VAT, TDS, AdvanceTax, IncomeTaxReturn}, kinds)
+
+	var k Kind
+	assert.Equal(t, ErrInvalidKind, k.UnmarshalText([]byte("GST")))
+	assert.Equal(t, Kind(0), k)
+}
@@ -33,24 +33,9 @@ func fromGregorian(t time.Time) Time {
 
 	// find the BS date according to the reasoning above, distributing the
 	// daysElapsed along the data grid.
-	year, month, days := func() (int, int, int) {
-		for i := bsLBoundY; i <= bsUBoundY; i++ {
-			for j := 0; j < 12; j++ {
-				days := bsDaysInMonthsByYear[i][j]
+	r := rawFromDaysElapsed(daysElapsed)
 
-				if days <= daysElapsed {
-					daysElapsed = daysElapsed - days
-					continue
-				}
-
-				return i, j + 1, daysElapsed + 1
-			}
-		}
-
-		return -1, -1, -1
-	}()
-
-	return Time{t, year, Month(month), days}
+	return Time{t, r.year, r.month, r.day}
 }
 
 // Constructs a valid B.S. time from a 'raw' B.S. time.
@@ -80,24 +65,7 @@ func fromRaw(r raw) Time {
 		day:   r.day,
 	}
 
-	year, month, day := func() (int, time.Month, int) {
-		totalDiff := 0
-
-		// Count the number of days in the years
-		for i := bsLBoundY; i < r.year; i++ {
-			totalDiff += numDaysInYear(i)
-		}
-
-		// Count the number of days in the months
-		for i := 0; i < int(r.month)-1; i++ {
-			totalDiff += bsDaysInMonthsByYear[r.year][i]
-		}
-
-		// Add the leftover days
-		totalDiff += r.day - 1
-
-		return glow.AddDate(0, 0, totalDiff).Date()
-	}()
+	year, month, day := glow.AddDate(0, 0, r.daysElapsed()).Date()
 
 	g := gregorian(year, int(month), day)
 
@@ -106,3 +74,45 @@ func fromRaw(r raw) Time {
 
 	return t
 }
+
+// daysElapsed returns the number of days elapsed between the lower bound B.S.
+// date and this raw date. The raw date is expected to be in range.
+func (r raw) daysElapsed() int {
+	totalDiff := 0
+
+	// Count the number of days in the years
+	for i := bsLBoundY; i < r.year; i++ {
+		totalDiff += numDaysInYear(i)
+	}
+
+	// Count the number of days in the months
+	for i := 0; i < int(r.month)-1; i++ {
+		totalDiff += bsDaysInMonthsByYear[r.year][i]
+	}
+
+	// Add the leftover days
+	totalDiff += r.day - 1
+
+	return totalDiff
+}
+
+// rawFromDaysElapsed is the inverse of 'daysElapsed'; it distributes the number
+// of days elapsed since the lower bound B.S. date along the data grid to find the
+// raw date. If the days fall outside the data grid, a raw date of (-1, -1, -1) is
+// returned.
+func rawFromDaysElapsed(daysElapsed int) raw {
+	for i := bsLBoundY; i <= bsUBoundY; i++ {
+		for j := 0; j < 12; j++ {
+			days := bsDaysInMonthsByYear[i][j]
+
+			if days <= daysElapsed {
+				daysElapsed = daysElapsed - days
+				continue
+			}
+
+			return raw{i, Month(j + 1), daysElapsed + 1}
+		}
+	}
+
+	return raw{-1, -1, -1}
+}
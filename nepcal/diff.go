@@ -0,0 +1,35 @@
+package nepcal
+
+// Diff returns the time elapsed from 'from' until 'to' in B.S. years, months and
+// days, as used for ages on Nepali official forms. Years and months are counted
+// on B.S. month boundaries using the real length of each month, the same way
+// as Humanize: a month is complete when the same day of the month is reached,
+// or the last day of the month if it has fewer days than that. The remaining
+// days are counted from there.
+//
+// For example, from Shrawan 32 to Bhadra 31 (its last day) is exactly one month,
+// and from Baisakh 15, 2050 to Jestha 10, 2081 is 31 years and 26 days.
+//
+// If 'from' is after 'to', all three values are negative.
+func Diff(from, to Time) (years, months, days int) {
+	if from.After(to) {
+		years, months, days = Diff(to, from)
+
+		return -years, -months, -days
+	}
+
+	total := monthsBetween(from, to)
+
+	// The date at which the last complete month ended.
+	y := from.year + (int(from.month)-1+total)/12
+	m := Month((int(from.month)-1+total)%12 + 1)
+
+	d := from.day
+	if n := m.numDaysUnchecked(y); d > n {
+		d = n
+	}
+
+	anniversary := raw{y, m, d}
+
+	return total / 12, total % 12, to.toRaw().daysElapsed() - anniversary.daysElapsed()
+}
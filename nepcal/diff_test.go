@@ -0,0 +1,36 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name                string
+		from, to            Time
+		years, months, days int
+	}{
+		{"same day", DateUnchecked(2081, Shrawan, 15), DateUnchecked(2081, Shrawan, 15), 0, 0, 0},
+		{"days", DateUnchecked(2081, Shrawan, 15), DateUnchecked(2081, Shrawan, 32), 0, 0, 17},
+		{"across a month", DateUnchecked(2081, Shrawan, 30), DateUnchecked(2081, Bhadra, 2), 0, 0, 4},
+		{"whole month", DateUnchecked(2081, Shrawan, 15), DateUnchecked(2081, Bhadra, 15), 0, 1, 0},
+		{"end of a shorter month", DateUnchecked(2081, Shrawan, 32), DateUnchecked(2081, Bhadra, 31), 0, 1, 0},
+		{"after the end of a shorter month", DateUnchecked(2081, Shrawan, 32), DateUnchecked(2081, Ashoj, 1), 0, 1, 1},
+		{"whole year", DateUnchecked(2080, Chaitra, 30), DateUnchecked(2081, Chaitra, 30), 1, 0, 0},
+		{"age", DateUnchecked(2050, Baisakh, 15), DateUnchecked(2081, Jestha, 10), 31, 0, 26},
+		{"age across years", DateUnchecked(2050, Poush, 20), DateUnchecked(2081, Shrawan, 15), 30, 6, 26},
+		{"reversed", DateUnchecked(2081, Jestha, 10), DateUnchecked(2050, Baisakh, 15), -31, 0, -26},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			years, months, days := Diff(test.from, test.to)
+
+			assert.Equal(t, test.years, years)
+			assert.Equal(t, test.months, months)
+			assert.Equal(t, test.days, days)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package nepcal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Layouts for use with Time.Format.
+const (
+	// ISODate is the ISO 8601 style layout of a B.S. date, e.g. 2081-04-15.
+	ISODate = "2006-01-02"
+
+	// NepaliDate is the layout used by Time.String, e.g. साउन १५, २०८१ मंगलबार.
+	NepaliDate = "बैशाख २, २००६ सोमबार"
+)
+
+// A layout element and the function rendering it for a date.
+type layoutElem struct {
+	elem   string
+	render func(t Time) string
+}
+
+// layoutElems are the supported elements of a layout, in the order that they
+// are matched. Longer elements must come before their prefixes.
+var layoutElems = []layoutElem{
+	{"January", func(t Time) string { return monthRomanNames[t.Month()] }},
+	{"Jan", func(t Time) string { return monthRomanNames[t.Month()][:3] }},
+	{"Monday", func(t Time) string { return time.Weekday(t.Weekday()).String() }},
+	{"Mon", func(t Time) string { return time.Weekday(t.Weekday()).String()[:3] }},
+	{"बैशाख", func(t Time) string { return t.Month().Name() }},
+	{"सोमबार", func(t Time) string { return t.Weekday().Name() }},
+	{"सोम", func(t Time) string { return strings.TrimSuffix(t.Weekday().Name(), "बार") }},
+	{"2006", func(t Time) string { return fmt.Sprintf("%04d", t.Year()) }},
+	{"२००६", func(t Time) string { return Numeral(t.Year()).Pad(4) }},
+	{"01", func(t Time) string { return fmt.Sprintf("%02d", t.Month()) }},
+	{"०१", func(t Time) string { return Numeral(t.Month()).Pad(2) }},
+	{"02", func(t Time) string { return fmt.Sprintf("%02d", t.Day()) }},
+	{"०२", func(t Time) string { return Numeral(t.Day()).Pad(2) }},
+	{"_2", func(t Time) string { return fmt.Sprintf("%2d", t.Day()) }},
+	{"1", func(t Time) string { return strconv.Itoa(int(t.Month())) }},
+	{"१", func(t Time) string { return Numeral(t.Month()).String() }},
+	{"2", func(t Time) string { return strconv.Itoa(t.Day()) }},
+	{"२", func(t Time) string { return Numeral(t.Day()).String() }},
+}
+
+// Format returns a textual representation of the date in the layout provided.
+// Much like time.Time.Format, the layout shows how the reference date would be
+// written, with the following elements replaced by their B.S. values:
+//
+//	2006, 01, 1     year, zero padded month and month
+//	02, _2, 2       zero padded, space padded and unpadded day
+//	२००६, ०१, १, ०२, २  the same, written with Nepali numerals
+//	January, Jan    full and abbreviated romanised month, e.g. Shrawan and Shr
+//	Monday, Mon     full and abbreviated English weekday, e.g. Tuesday and Tue
+//	बैशाख           Nepali month, e.g. साउन
+//	सोमबार, सोम       full and abbreviated Nepali weekday, e.g. मंगलबार and मंगल
+//
+// As with the numerals, the Nepali names are written in place of the name of
+// the first month (बैशाख) and of the reference weekday (सोमबार). Everything
+// else in the layout is copied as it is.
+func (t Time) Format(layout string) string {
+	var b strings.Builder
+
+	for layout != "" {
+		matched := false
+
+		for _, le := range layoutElems {
+			if strings.HasPrefix(layout, le.elem) {
+				b.WriteString(le.render(t))
+				layout = layout[len(le.elem):]
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			// Copy the next rune as it is; layouts may contain Devanagari text.
+			_, size := utf8.DecodeRuneInString(layout)
+			b.WriteString(layout[:size])
+			layout = layout[size:]
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,38 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     Time
+		layout   string
+		expected string
+	}{
+		{"iso", DateUnchecked(2081, Shrawan, 5), ISODate, "2081-04-05"},
+		{"nepali", DateUnchecked(2081, Shrawan, 15), NepaliDate, "साउन १५, २०८१ मंगलबार"},
+		{"unpadded", DateUnchecked(2081, Shrawan, 5), "1/2/2006", "4/5/2081"},
+		{"space padded", DateUnchecked(2081, Shrawan, 5), "[_2]", "[ 5]"},
+		{"names", DateUnchecked(2081, Shrawan, 15), "Monday, 2 January 2006", "Tuesday, 15 Shrawan 2081"},
+		{"abbreviated names", DateUnchecked(2081, Shrawan, 15), "Mon, 2 Jan 2006", "Tue, 15 Shr 2081"},
+		{"nepali names", DateUnchecked(2081, Shrawan, 15), "सोमबार, २ बैशाख (सोम)", "मंगलबार, १५ साउन (मंगल)"},
+		{"numerals", DateUnchecked(2081, Shrawan, 5), "२००६/०१/०२ (१/२)", "२०८१/०४/०५ (४/५)"},
+		{"literal text", DateUnchecked(2081, Shrawan, 15), "२ गते बैशाख, year: 2006", "१५ गते साउन, year: 2081"},
+		{"empty", DateUnchecked(2081, Shrawan, 15), "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.date.Format(test.layout))
+		})
+	}
+
+	t.Run("matches String", func(t *testing.T) {
+		d := DateUnchecked(2053, Mangshir, 18)
+		assert.Equal(t, d.String(), d.Format(NepaliDate))
+	})
+}
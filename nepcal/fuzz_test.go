@@ -0,0 +1,128 @@
+package nepcal
+
+import (
+	"testing"
+	"time"
+)
+
+func FuzzDate(f *testing.F) {
+	f.Add(2081, 4, 15)
+	f.Add(bsLBoundY, bsLBoundM, bsLBoundD)
+	f.Add(bsUBoundY, bsUBoundM, bsUBoundD)
+	f.Add(2081, 4, 33)
+	f.Add(2081, 13, 1)
+	f.Add(10000, 1, 1)
+	f.Add(999, 1, 1)
+
+	f.Fuzz(func(t *testing.T, year, month, day int) {
+		bs, err := Date(year, Month(month), day)
+		if err != nil {
+			if err != ErrOutOfBounds {
+				t.Fatalf("Date(%d, %d, %d): unexpected error %v", year, month, day, err)
+			}
+
+			if IsInRangeBS(year, Month(month), day) {
+				t.Fatalf("Date(%d, %d, %d) is in range but returned %v", year, month, day, err)
+			}
+
+			return
+		}
+
+		if y, m, d := bs.Date(); y != year || m != Month(month) || d != day {
+			t.Fatalf("Date(%d, %d, %d) = %d-%d-%d", year, month, day, y, m, d)
+		}
+
+		if !IsInRangeGregorian(bs.Gregorian()) {
+			t.Fatalf("Date(%d, %d, %d) is AD %s, which is out of range", year, month, day, bs.Gregorian())
+		}
+
+		back, err := FromGregorian(bs.Gregorian())
+		if err != nil || back.toRaw() != bs.toRaw() {
+			t.Fatalf("Date(%d, %d, %d) is AD %s, which converts back to %v (%v)", year, month, day, bs.Gregorian(), back, err)
+		}
+	})
+}
+
+func FuzzFromGregorian(f *testing.F) {
+	f.Add(int64(0), 0)
+	f.Add(gregorian(adLBoundY, adLBoundM, adLBoundD).Unix(), 0)
+	f.Add(gregorian(adUBoundY, adUBoundM, adUBoundD).Unix(), 0)
+	f.Add(gregorian(2024, 7, 16).Unix()-1, 0)
+	f.Add(gregorian(2024, 7, 16).Unix(), 5*60*60+45*60)
+	f.Add(int64(-1<<40), -12*60*60)
+
+	f.Fuzz(func(t *testing.T, unix int64, offset int) {
+		// Keep the offset within the range of real time zones.
+		offset %= 18 * 60 * 60
+		in := time.Unix(unix, 0).In(time.FixedZone("", offset))
+
+		bs, err := FromGregorian(in)
+		if err != nil {
+			if err != ErrOutOfBounds || IsInRangeGregorian(in) {
+				t.Fatalf("FromGregorian(%s): unexpected error %v", in, err)
+			}
+
+			return
+		}
+
+		if !IsInRangeBS(bs.Date()) {
+			t.Fatalf("FromGregorian(%s) = %v, which is out of range", in, bs)
+		}
+
+		// The date is the same as that of 'in' in its own time zone.
+		y, m, d := in.Date()
+
+		back, err := Date(bs.Date())
+		if err != nil || !back.Gregorian().Equal(gregorian(y, int(m), d)) {
+			t.Fatalf("FromGregorian(%s) = %v, which converts back to %s (%v)", in, bs, back.Gregorian(), err)
+		}
+	})
+}
+
+func FuzzParseFuzzy(f *testing.F) {
+	for _, s := range []string{"today", "tomorrow", "भोलि", "next friday", "+10d", "-2w", "15 Shrawan 2081", "साउन १५ २०८१", "32 Shrawan 2081", "+99999999999d", "1 baisakh 1975"} {
+		f.Add(s)
+	}
+
+	ref := DateUnchecked(2081, Shrawan, 15)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		bs, err := ParseFuzzy(s, ref)
+		if err != nil {
+			if err != ErrInvalidDate && err != ErrOutOfBounds {
+				t.Fatalf("ParseFuzzy(%q): unexpected error %v", s, err)
+			}
+
+			return
+		}
+
+		if !IsInRangeBS(bs.Date()) || !IsInRangeGregorian(bs.Gregorian()) {
+			t.Fatalf("ParseFuzzy(%q) = %v (AD %s), which is out of range", s, bs, bs.Gregorian())
+		}
+
+		if back, err := Date(bs.Date()); err != nil || !back.Gregorian().Equal(bs.Gregorian()) {
+			t.Fatalf("ParseFuzzy(%q) = %v (AD %s), but Date returns AD %s (%v)", s, bs, bs.Gregorian(), back.Gregorian(), err)
+		}
+	})
+}
+
+func FuzzParseNumeral(f *testing.F) {
+	for _, s := range []string{"४२", "-४२", "१२,३४,५६७", "+7", "", "-", "९९९९९९९९९९९९९९९९९९९९"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		n, err := ParseNumeral(s)
+		if err != nil {
+			if err != ErrInvalidNumeral {
+				t.Fatalf("ParseNumeral(%q): unexpected error %v", s, err)
+			}
+
+			return
+		}
+
+		if back, err := ParseNumeral(n.String()); err != nil || back != n {
+			t.Fatalf("ParseNumeral(%q) = %d, but its string %q parses to %d (%v)", s, n, n.String(), back, err)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package nepcal
+
+import "fmt"
+
+// Humanize returns a human readable description of the date 't' relative to 'now',
+// such as "आज", "हिजो", "३ दिन अघि" or "२ महिना पछि" for the Nepali locale, and
+// "today", "yesterday", "3 days ago" or "in 2 months" for the English locale.
+//
+// Months and years are counted on B.S. month and year boundaries rather than
+// being approximated as 30 or 365 days, i.e. Jestha 15 is one month after
+// Baisakh 15 regardless of the number of days in Baisakh.
+func Humanize(t, now Time, locale Locale) string {
+	days := t.JulianDay() - now.JulianDay()
+
+	switch days {
+	case 0:
+		return relativeWords[locale].today
+	case -1:
+		return relativeWords[locale].yesterday
+	case 1:
+		return relativeWords[locale].tomorrow
+	}
+
+	past := days < 0
+
+	from, to := now, t
+	if past {
+		from, to = t, now
+		days = -days
+	}
+
+	months := monthsBetween(from, to)
+
+	switch {
+	case months >= 12:
+		return relative(months/12, unitYear, past, locale)
+	case months >= 1:
+		return relative(months, unitMonth, past, locale)
+	default:
+		return relative(days, unitDay, past, locale)
+	}
+}
+
+// Units of time used in relative descriptions.
+type unit int
+
+const (
+	unitDay unit = iota
+	unitMonth
+	unitYear
+)
+
+// relativeWords holds the words for each locale used in relative descriptions.
+var relativeWords = map[Locale]struct {
+	today, yesterday, tomorrow string
+	units                      map[unit][2]string // singular and plural forms
+}{
+	Nepali: {
+		today:     "आज",
+		yesterday: "हिजो",
+		tomorrow:  "भोलि",
+		units: map[unit][2]string{
+			unitDay:   {"दिन", "दिन"},
+			unitMonth: {"महिना", "महिना"},
+			unitYear:  {"वर्ष", "वर्ष"},
+		},
+	},
+	English: {
+		today:     "today",
+		yesterday: "yesterday",
+		tomorrow:  "tomorrow",
+		units: map[unit][2]string{
+			unitDay:   {"day", "days"},
+			unitMonth: {"month", "months"},
+			unitYear:  {"year", "years"},
+		},
+	},
+}
+
+// relative formats 'n' units of time either in the past or in the future.
+func relative(n int, u unit, past bool, locale Locale) string {
+	forms := relativeWords[locale].units[u]
+
+	name := forms[1]
+	if n == 1 {
+		name = forms[0]
+	}
+
+	if locale == Nepali {
+		suffix := "पछि"
+		if past {
+			suffix = "अघि"
+		}
+
+		return fmt.Sprintf("%s %s %s", Numeral(n), name, suffix)
+	}
+
+	if past {
+		return fmt.Sprintf("%d %s ago", n, name)
+	}
+
+	return fmt.Sprintf("in %d %s", n, name)
+}
+
+// monthsBetween returns the number of complete B.S. months from 'from' until 'to',
+// where 'from' is not after 'to'. A month is complete when the same day of the
+// month is reached, or the last day of the month if it has fewer days than that.
+func monthsBetween(from, to Time) int {
+	months := (to.year-from.year)*12 + int(to.month) - int(from.month)
+
+	if to.day < from.day && to.day < to.NumDaysInMonth() {
+		months--
+	}
+
+	return months
+}
@@ -0,0 +1,45 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanize(t *testing.T) {
+	now := DateUnchecked(2081, Shrawan, 15)
+
+	tests := []struct {
+		name    string
+		t       Time
+		nepali  string
+		english string
+	}{
+		{"today", now, "आज", "today"},
+		{"yesterday", DateUnchecked(2081, Shrawan, 14), "हिजो", "yesterday"},
+		{"tomorrow", DateUnchecked(2081, Shrawan, 16), "भोलि", "tomorrow"},
+		{"days ago", DateUnchecked(2081, Shrawan, 12), "३ दिन अघि", "3 days ago"},
+		{"days later", DateUnchecked(2081, Bhadra, 14), "३१ दिन पछि", "in 31 days"},
+		{"one month later", DateUnchecked(2081, Bhadra, 15), "१ महिना पछि", "in 1 month"},
+		{"months later", DateUnchecked(2081, Ashoj, 20), "२ महिना पछि", "in 2 months"},
+		{"months ago", DateUnchecked(2081, Baisakh, 15), "३ महिना अघि", "3 months ago"},
+		{"year boundary", DateUnchecked(2080, Shrawan, 15), "१ वर्ष अघि", "1 year ago"},
+		{"years later", DateUnchecked(2083, Ashar, 1), "१ वर्ष पछि", "in 1 year"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.nepali, Humanize(test.t, now, Nepali))
+			assert.Equal(t, test.english, Humanize(test.t, now, English))
+		})
+	}
+
+	t.Run("short months", func(t *testing.T) {
+		// Jestha 2081 has 32 days whereas Ashar has 31, so the last day of
+		// Ashar completes a month from Jestha 32.
+		from := DateUnchecked(2081, Jestha, 32)
+
+		assert.Equal(t, "in 30 days", Humanize(DateUnchecked(2081, Ashar, 30), from, English))
+		assert.Equal(t, "in 1 month", Humanize(DateUnchecked(2081, Ashar, 31), from, English))
+	})
+}
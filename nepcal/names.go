@@ -0,0 +1,157 @@
+package nepcal
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidMonth is the error returned when a month name can not be parsed.
+	ErrInvalidMonth = errors.New("Unable to parse the provided month")
+
+	// ErrInvalidWeekday is the error returned when a weekday name can not be parsed.
+	ErrInvalidWeekday = errors.New("Unable to parse the provided weekday")
+)
+
+// monthVariants are the accepted spellings of each month in addition to
+// the Devanagari name and its transliterations.
+var monthVariants = map[Month][]string{
+	Baisakh:  {"baisakh", "baishakh", "vaisakh", "vaishakh", "baisakha", "baishakha", "vaisakha", "vaishakha", "bai", "बैसाख", "वैशाख"},
+	Jestha:   {"jestha", "jeth", "jeshtha", "jyestha", "jyeshtha", "jes", "jet", "जेष्ठ", "ज्येष्ठ"},
+	Ashar:    {"ashar", "asar", "aashar", "ashad", "asadh", "ashadh", "asadha", "ashadha", "asa", "आषाढ", "अषाढ"},
+	Shrawan:  {"shrawan", "shravan", "srawan", "sravan", "saun", "sawan", "shr", "श्रावण"},
+	Bhadra:   {"bhadra", "bhadau", "bhadrapad", "bha", "भाद्र"},
+	Ashoj:    {"ashoj", "asoj", "ashwin", "aswin", "ashvin", "aso", "asw", "आश्विन"},
+	Kartik:   {"kartik", "kartika", "kattik", "kar", "कात्तिक"},
+	Mangshir: {"mangshir", "mangsir", "mansir", "margashirsha", "marga", "man", "मङ्सिर", "मार्गशीर्ष"},
+	Poush:    {"poush", "paush", "push", "pous", "pus", "pou", "पुस", "पूस"},
+	Magh:     {"magh", "maagh", "mag", "माघ"},
+	Falgun:   {"falgun", "phalgun", "fagun", "phagun", "fal", "pha", "फाल्गुन"},
+	Chaitra:  {"chaitra", "chait", "chaita", "cha", "chai", "चैत्र"},
+}
+
+// weekdayVariants are the accepted spellings of each weekday in addition to
+// the Devanagari name, its transliterations, and the English name.
+var weekdayVariants = map[Weekday][]string{
+	Sunday:    {"sun", "su", "aaitabar", "aitabar", "aitbar", "आइतवार", "आइत"},
+	Monday:    {"mon", "mo", "somabar", "sombar", "sombaar", "सोमवार", "सोम"},
+	Tuesday:   {"tue", "tues", "tu", "mangalabar", "mangalbar", "मङ्गलबार", "मंगलवार", "मंगल"},
+	Wednesday: {"wed", "we", "budhabar", "budhbar", "बुधवार", "बुध"},
+	Thursday:  {"thu", "thur", "thurs", "th", "bihibar", "bihibaar", "brihaspatibar", "बिहीबार", "बिहिवार", "बृहस्पतिबार", "बिही"},
+	Friday:    {"fri", "fr", "shukrabar", "sukrabar", "shukrbar", "शुक्रवार", "शुक्र"},
+	Saturday:  {"sat", "sa", "shanibar", "sanibar", "शनिवार", "शनि"},
+}
+
+// Indices of every accepted, normalized name to the month or weekday.
+var (
+	monthsByName   = monthNameIndex()
+	weekdaysByName = weekdayNameIndex()
+)
+
+// ParseMonth parses a month name. Parsing is case-insensitive and accepts the
+// Devanagari name, its transliterations, the romanised name used for the Month
+// constants, common spelling variants (e.g. Baisakh, Baishakh, Vaishakh) and
+// short forms (e.g. Bai, Jes). An ErrInvalidMonth is returned otherwise.
+func ParseMonth(s string) (Month, error) {
+	m, ok := monthsByName[normalizeName(s)]
+	if !ok {
+		return -1, ErrInvalidMonth
+	}
+
+	return m, nil
+}
+
+// ParseWeekday parses a weekday name. Parsing is case-insensitive and accepts the
+// Devanagari name, its transliterations, the English name, common spelling
+// variants (e.g. Sombar, Somabar) and short forms (e.g. Mon, सोम). An
+// ErrInvalidWeekday is returned otherwise.
+func ParseWeekday(s string) (Weekday, error) {
+	w, ok := weekdaysByName[normalizeName(s)]
+	if !ok {
+		return -1, ErrInvalidWeekday
+	}
+
+	return w, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The month is
+// encoded as its romanised name, e.g. "Shrawan".
+func (m Month) MarshalText() ([]byte, error) {
+	name, ok := monthRomanNames[m]
+	if !ok {
+		return nil, ErrInvalidMonth
+	}
+
+	return []byte(name), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Any name
+// accepted by ParseMonth can be decoded.
+func (m *Month) UnmarshalText(text []byte) error {
+	parsed, err := ParseMonth(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The weekday is
+// encoded as its English name, e.g. "Sunday".
+func (w Weekday) MarshalText() ([]byte, error) {
+	if w < Sunday || w > Saturday {
+		return nil, ErrInvalidWeekday
+	}
+
+	return []byte(time.Weekday(w).String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Any name
+// accepted by ParseWeekday can be decoded.
+func (w *Weekday) UnmarshalText(text []byte) error {
+	parsed, err := ParseWeekday(string(text))
+	if err != nil {
+		return err
+	}
+
+	*w = parsed
+
+	return nil
+}
+
+// normalizeName normalizes month and weekday names for lookups.
+func normalizeName(s string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), ".")
+}
+
+func monthNameIndex() map[string]Month {
+	index := map[string]Month{}
+	for m := Baisakh; m <= Chaitra; m++ {
+		names := append([]string{m.Name(), monthRomanNames[m]}, monthVariants[m]...)
+		for _, name := range append(names, transliterations(m.Name())...) {
+			index[normalizeName(name)] = m
+		}
+	}
+
+	return index
+}
+
+func weekdayNameIndex() map[string]Weekday {
+	index := map[string]Weekday{}
+	for w := Sunday; w <= Saturday; w++ {
+		names := append([]string{w.Name(), time.Weekday(w).String()}, weekdayVariants[w]...)
+		for _, name := range append(names, transliterations(w.Name())...) {
+			index[normalizeName(name)] = w
+		}
+	}
+
+	return index
+}
+
+// transliterations returns the romanisations of 's' in every scheme.
+func transliterations(s string) []string {
+	return []string{Transliterate(s, IAST), Transliterate(s, ISO15919), Transliterate(s, ASCII)}
+}
@@ -0,0 +1,127 @@
+package nepcal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMonth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Month
+	}{
+		{"बैशाख", Baisakh},
+		{"Baisakh", Baisakh},
+		{"BAISHAKH", Baisakh},
+		{"vaishakh", Baisakh},
+		{"baiśākha", Baisakh},
+		{"Bai.", Baisakh},
+		{"jeth", Jestha},
+		{"Asar", Ashar},
+		{"saun", Shrawan},
+		{"Shravan", Shrawan},
+		{"bhadau", Bhadra},
+		{"Ashwin", Ashoj},
+		{"kartik", Kartik},
+		{"Mangsir", Mangshir},
+		{"mangshir", Mangshir},
+		{"मंसिर", Mangshir},
+		{"Push", Poush},
+		{"Paush", Poush},
+		{"पुस", Poush},
+		{"magh", Magh},
+		{"Phagun", Falgun},
+		{" chait ", Chaitra},
+		{"चैत", Chaitra},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			m, err := ParseMonth(test.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, m)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for m := Baisakh; m <= Chaitra; m++ {
+			for _, s := range []string{m.Name(), monthRomanNames[m], m.Transliterate(ASCII), m.Transliterate(IAST)} {
+				parsed, err := ParseMonth(s)
+				assert.NoError(t, err, s)
+				assert.Equal(t, m, parsed, s)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{"", "january", "13", "baisakhh"} {
+			_, err := ParseMonth(input)
+			assert.Equal(t, ErrInvalidMonth, err, input)
+		}
+	})
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Weekday
+	}{
+		{"आइतबार", Sunday},
+		{"sunday", Sunday},
+		{"Sun", Sunday},
+		{"aitbar", Sunday},
+		{"Sombar", Monday},
+		{"somabar", Monday},
+		{"सोम", Monday},
+		{"TUE", Tuesday},
+		{"mangalbar", Tuesday},
+		{"budhbar", Wednesday},
+		{"Thurs", Thursday},
+		{"बिहीबार", Thursday},
+		{"shukrabar", Friday},
+		{"शनिवार", Saturday},
+		{"sat", Saturday},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			w, err := ParseWeekday(test.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, w)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{"", "someday", "baisakh"} {
+			_, err := ParseWeekday(input)
+			assert.Equal(t, ErrInvalidWeekday, err, input)
+		}
+	})
+}
+
+func TestMonthWeekdayText(t *testing.T) {
+	type payload struct {
+		Month   Month   `json:"month"`
+		Weekday Weekday `json:"weekday"`
+	}
+
+	b, err := json.Marshal(payload{Shrawan, Tuesday})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"month":"Shrawan","weekday":"Tuesday"}`, string(b))
+
+	var p payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"month":"साउन","weekday":"mangalbar"}`), &p))
+	assert.Equal(t, payload{Shrawan, Tuesday}, p)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"month":"nope"}`), &p))
+
+	_, err = Month(13).MarshalText()
+	assert.Equal(t, ErrInvalidMonth, err)
+
+	_, err = Weekday(7).MarshalText()
+	assert.Equal(t, ErrInvalidWeekday, err)
+}
@@ -28,6 +28,10 @@ import (
 // ErrOutOfBounds is the error returned for out of bounds Gregorian dates.
 var ErrOutOfBounds = errors.New("Provided date out of bounds; consult function/method documentation")
 
+// NepalTime is the time zone of Nepal, UTC+05:45. It is useful to determine the
+// current date in Nepal regardless of the local time zone, e.g. in servers.
+var NepalTime = time.FixedZone("NPT", 5*60*60+45*60)
+
 // A Time struct represents a single Bikram Sambat date. An instance of this struct is
 // the primary way to interact with most functionality.
 // It can be created in two ways:
@@ -111,6 +115,27 @@ func DateUnchecked(year int, month Month, day int) Time {
 	return fromRaw(inraw)
 }
 
+// FromJulianDay constructs a B.S. date from the provided Julian Day Number.
+// An ErrOutOfBounds is returned if the day is outside the supported date range.
+func FromJulianDay(jdn int) (Time, error) {
+	year, month, day, err := BikramSambat.FromJulianDay(jdn)
+	if err != nil {
+		return Time{}, err
+	}
+
+	return fromRaw(raw{year, Month(month), day}), nil
+}
+
+// unixEpochJDN is the Julian Day Number of the Unix epoch, January 1, 1970.
+const unixEpochJDN = 2440588
+
+// FromUnixDays constructs a B.S. date from the number of days elapsed since
+// the Unix epoch (January 1, 1970). Negative values are days before the epoch.
+// An ErrOutOfBounds is returned if the day is outside the supported date range.
+func FromUnixDays(days int) (Time, error) {
+	return FromJulianDay(days + unixEpochJDN)
+}
+
 // Gregorian returns the A.D. equivalent of this date. If this struct was initially created
 // from a gregorian date, then it returns the same input date. Otherwise, if it was created from a raw
 // B.S. date using the "Date" method, then it returns the A.D. representation of that date.
@@ -181,6 +206,21 @@ func (t Time) NumDaysSpanned() int {
 	return sum
 }
 
+// JulianDay returns the Julian Day Number for this date. Since Julian Day
+// Numbers are consecutive integers, they can be used to store dates compactly
+// and to compare or subtract them arithmetically.
+func (t Time) JulianDay() int {
+	yy, mm, dd := t.in.Date()
+
+	return gregorianToJDN(yy, int(mm), dd)
+}
+
+// UnixDays returns the number of days elapsed between the Unix epoch (January 1, 1970)
+// and this date. Dates before the epoch have negative values.
+func (t Time) UnixDays() int {
+	return t.JulianDay() - unixEpochJDN
+}
+
 // Calendar returns an io.Reader which can be used to read the calendar
 // representation of this date.
 func (t Time) Calendar() io.Reader {
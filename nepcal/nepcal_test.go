@@ -88,6 +88,75 @@ func TestFromGregorian(t *testing.T) {
 		_, err := FromGregorian(gregorian(adLBoundY, 04, 01))
 		assert.Equal(t, err, ErrOutOfBounds)
 	})
+
+	t.Run("errors if date is after the upper bound", func(t *testing.T) {
+		_, err := FromGregorian(gregorian(adUBoundY, adUBoundM, adUBoundD+1))
+		assert.Equal(t, err, ErrOutOfBounds)
+	})
+}
+
+func TestIsInRangeGregorian(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected bool
+	}{
+		{"lower bound", gregorian(1918, 4, 13), true},
+		{"before the lower bound", gregorian(1918, 4, 12), false},
+		{"within the range", gregorian(2024, 7, 30), true},
+		{"upper bound", gregorian(2044, 4, 12), true},
+		{"end of the upper bound", time.Date(2044, 4, 12, 23, 59, 59, 0, time.UTC), true},
+		{"upper bound in another time zone", time.Date(2044, 4, 12, 23, 0, 0, 0, time.FixedZone("NPT", 5*3600+45*60)), true},
+		{"after the upper bound", gregorian(2044, 4, 13), false},
+		{"far after the upper bound", gregorian(9999, 1, 1), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsInRangeGregorian(test.t))
+		})
+	}
+}
+
+func TestIsInRangeBS(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     raw
+		expected bool
+	}{
+		{"lower bound", raw{1975, Baisakh, 1}, true},
+		{"before the lower bound", raw{1974, Chaitra, 30}, false},
+		{"within the range", raw{2081, Shrawan, 15}, true},
+		{"upper bound", raw{2100, Chaitra, 30}, true},
+		{"after the upper bound", raw{2101, Baisakh, 1}, false},
+		{"month 0", raw{2081, 0, 1}, false},
+		{"month 13", raw{2081, 13, 1}, false},
+		{"day 0", raw{2081, Shrawan, 0}, false},
+		{"last day of a 31 day month", raw{2081, Baisakh, 31}, true},
+		{"day 32 of a 31 day month", raw{2081, Baisakh, 32}, false},
+		{"day 33", raw{2081, Jestha, 33}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsInRangeBS(test.date.year, test.date.month, test.date.day))
+		})
+	}
+
+	t.Run("Date rejects dates that do not exist", func(t *testing.T) {
+		for _, r := range []raw{{2081, 13, 1}, {2081, 0, 1}, {2081, Baisakh, 32}, {2101, Baisakh, 1}, {999, Baisakh, 1}} {
+			assert.NotPanics(t, func() {
+				_, err := Date(r.year, r.month, r.day)
+				assert.Equal(t, ErrOutOfBounds, err, "%v", r)
+			})
+		}
+	})
+
+	t.Run("lower bound is a valid date", func(t *testing.T) {
+		bs, err := Date(bsLBoundY, bsLBoundM, bsLBoundD)
+		assert.NoError(t, err)
+		assert.Equal(t, gregorian(adLBoundY, adLBoundM, adLBoundD), bs.Gregorian())
+	})
 }
 
 func TestBsAdConversion(t *testing.T) {
@@ -136,6 +205,11 @@ func TestBsAdConversion(t *testing.T) {
 			raw{2076, 01, 22},
 			gregorian(2019, 05, 05),
 		},
+		{
+			"lower bound",
+			raw{1975, 01, 01},
+			gregorian(1918, 04, 13),
+		},
 	}
 
 	for _, test := range tests {
@@ -259,3 +333,46 @@ func TestAfter(t *testing.T) {
 	assert.Equal(t, true, t1.After(t2))
 	assert.Equal(t, false, t2.After(t1))
 }
+
+func TestJulianDay(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      Time
+		jdn       int
+		unixDays  int
+		gregorian time.Time
+	}{
+		{"unix epoch", DateUnchecked(2026, Poush, 17), 2440588, 0, gregorian(1970, 1, 1)},
+		{"J2000", DateUnchecked(2056, Poush, 17), 2451545, 10957, gregorian(2000, 1, 1)},
+		{"lower bound", DateUnchecked(bsLBoundY, bsLBoundM, bsLBoundD), 2421697, -18891, gregorian(adLBoundY, adLBoundM, adLBoundD)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.gregorian, test.date.Gregorian())
+			assert.Equal(t, test.jdn, test.date.JulianDay())
+			assert.Equal(t, test.unixDays, test.date.UnixDays())
+
+			fromJDN, err := FromJulianDay(test.jdn)
+			assert.NoError(t, err)
+			assert.Equal(t, test.date, fromJDN)
+
+			fromUnix, err := FromUnixDays(test.unixDays)
+			assert.NoError(t, err)
+			assert.Equal(t, test.date, fromUnix)
+		})
+	}
+
+	t.Run("local times use the local date", func(t *testing.T) {
+		bs := FromGregorianUnchecked(dummyNepaliTime(2019, 05, 05))
+		assert.Equal(t, gregorianToJDN(2019, 5, 5), bs.JulianDay())
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, err := FromJulianDay(2421697 - 1)
+		assert.Equal(t, ErrOutOfBounds, err)
+
+		_, err = FromUnixDays(1 << 20)
+		assert.Equal(t, ErrOutOfBounds, err)
+	})
+}
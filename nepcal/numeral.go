@@ -0,0 +1,96 @@
+package nepcal
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNumeral is the error returned when a numeral can not be parsed.
+var ErrInvalidNumeral = errors.New("Unable to parse the provided numeral")
+
+// Numeral represents a Nepali number.
+type Numeral int
+
+// ParseNumeral parses a number written in Devanagari digits, such as "-४२" or
+// "१२,३४,५६७". An optional leading sign is allowed, and commas used for digit
+// grouping are ignored. ASCII digits are also accepted. An ErrInvalidNumeral is
+// returned if the string is not a valid number.
+func ParseNumeral(s string) (Numeral, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	n, ok := parseDigits(s)
+	if !ok {
+		return 0, ErrInvalidNumeral
+	}
+
+	return Numeral(sign * n), nil
+}
+
+// Returns the Nepali representation of this numeral.
+func (n Numeral) String() string {
+	return toDevanagariDigits(strconv.Itoa(int(n)))
+}
+
+// Pad returns the Nepali representation of this numeral, left padded with
+// zeros to at least 'width' digits. The sign of negative numbers is not
+// counted towards the width, e.g. Numeral(-5).Pad(2) is "-०५".
+func (n Numeral) Pad(width int) string {
+	sign, digits := n.split()
+	if len(digits) < width {
+		digits = strings.Repeat("0", width-len(digits)) + digits
+	}
+
+	return toDevanagariDigits(sign + digits)
+}
+
+// Grouped returns the Nepali representation of this numeral with the digits
+// grouped as per the Nepali (and Indian) numbering system, i.e. the last three
+// digits form the first group and the rest are grouped in pairs, separating
+// thousands, lakhs, crores and so on. For example, १२,३४,५६७.
+func (n Numeral) Grouped() string {
+	sign, digits := n.split()
+	if len(digits) <= 3 {
+		return toDevanagariDigits(sign + digits)
+	}
+
+	groups := []string{digits[len(digits)-3:]}
+	rest := digits[:len(digits)-3]
+	for len(rest) > 2 {
+		groups = append([]string{rest[len(rest)-2:]}, groups...)
+		rest = rest[:len(rest)-2]
+	}
+	groups = append([]string{rest}, groups...)
+
+	return toDevanagariDigits(sign + strings.Join(groups, ","))
+}
+
+// split returns the sign ("-" or "") and the ASCII digits of this numeral.
+func (n Numeral) split() (string, string) {
+	s := strconv.Itoa(int(n))
+	if strings.HasPrefix(s, "-") {
+		return "-", s[1:]
+	}
+
+	return "", s
+}
+
+// toDevanagariDigits replaces every ASCII digit in 's' with its Devanagari
+// counterpart, leaving every other character as is.
+func toDevanagariDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '०' + (r - '0')
+		}
+
+		return r
+	}, s)
+}
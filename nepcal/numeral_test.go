@@ -0,0 +1,99 @@
+package nepcal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumeralString(t *testing.T) {
+	tests := []struct {
+		name     string
+		num      int
+		expected string
+	}{
+		{"zero", 0, "०"},
+		{"negative", -5, "-५"},
+		{"negative multiple digits", -2081, "-२०८१"},
+		{"min int", math.MinInt64, "-९२२३३७२०३६८५४७७५८०८"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Numeral(test.num).String())
+		})
+	}
+}
+
+func TestNumeralPad(t *testing.T) {
+	assert.Equal(t, "०५", Numeral(5).Pad(2))
+	assert.Equal(t, "-००५", Numeral(-5).Pad(3))
+	assert.Equal(t, "२०८१", Numeral(2081).Pad(2))
+	assert.Equal(t, "०", Numeral(0).Pad(0))
+}
+
+func TestNumeralGrouped(t *testing.T) {
+	tests := []struct {
+		num      int
+		expected string
+	}{
+		{0, "०"},
+		{999, "९९९"},
+		{1000, "१,०००"},
+		{12345, "१२,३४५"},
+		{1234567, "१२,३४,५६७"},
+		{123456789, "१२,३४,५६,७८९"},
+		{-1234567, "-१२,३४,५६७"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			assert.Equal(t, test.expected, Numeral(test.num).Grouped())
+		})
+	}
+}
+
+func TestParseNumeral(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Numeral
+	}{
+		{"०", 0},
+		{"७५९", 759},
+		{"००५", 5},
+		{"-४२", -42},
+		{"+४२", 42},
+		{"१२,३४,५६७", 1234567},
+		{" २०८१ ", 2081},
+		{"2081", 2081},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			n, err := ParseNumeral(test.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, n)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, n := range []Numeral{0, 7, -7, 1234567, -98765432} {
+			parsed, err := ParseNumeral(n.String())
+			assert.NoError(t, err)
+			assert.Equal(t, n, parsed)
+
+			parsed, err = ParseNumeral(n.Grouped())
+			assert.NoError(t, err)
+			assert.Equal(t, n, parsed)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{"", "-", "१२a", "--१", "१.५", "९९९९९९९९९९९९९९९९९९९९९"} {
+			_, err := ParseNumeral(input)
+			assert.Equal(t, ErrInvalidNumeral, err, input)
+		}
+	})
+}
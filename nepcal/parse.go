@@ -0,0 +1,139 @@
+package nepcal
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDate is the error returned when a date can not be parsed.
+var ErrInvalidDate = errors.New("Unable to parse the provided date")
+
+// offsetRe matches relative offsets such as "+10d" or "-2w".
+var offsetRe = regexp.MustCompile(`^([+-])(\d+)([dw])$`)
+
+// ParseFuzzy parses a loosely formatted, human entered date relative to the
+// reference date 'ref'. Parsing is case-insensitive and the following forms
+// are understood:
+//
+//	today, yesterday, tomorrow (or आज, हिजो, भोलि)
+//	next friday, last friday   the closest such weekday after/before 'ref'
+//	+10d, -3d, +2w             days or weeks after/before 'ref'
+//	15 Shrawan 2081            a B.S. date; day, month and year in any order,
+//	साउन १५ २०८१                in either Devanagari or ASCII digits. The year
+//	                           defaults to that of 'ref' if omitted.
+//
+// An ErrInvalidDate is returned if the input is not understood, and an
+// ErrOutOfBounds if it is understood but outside the supported date range.
+func ParseFuzzy(s string, ref Time) (Time, error) {
+	fields := strings.Fields(strings.ToLower(strings.ReplaceAll(s, ",", " ")))
+	input := strings.Join(fields, " ")
+
+	switch input {
+	case "today", "now", "आज":
+		return ref, nil
+	case "yesterday", "हिजो":
+		return ref.AddDays(-1)
+	case "tomorrow", "भोलि":
+		return ref.AddDays(1)
+	}
+
+	if m := offsetRe.FindStringSubmatch(input); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return Time{}, ErrInvalidDate
+		}
+
+		if m[3] == "w" {
+			n *= 7
+		}
+
+		if m[1] == "-" {
+			n = -n
+		}
+
+		return ref.AddDays(n)
+	}
+
+	if len(fields) == 2 {
+		if w, err := ParseWeekday(fields[1]); err == nil {
+			switch fields[0] {
+			case "next":
+				return ref.Next(w)
+			case "last", "previous":
+				return ref.Previous(w)
+			}
+		}
+	}
+
+	return parseBSPhrase(fields, ref)
+}
+
+// parseBSPhrase parses B.S. dates written as a month name along with a day and
+// an optional year, in any order. The year is the number with more than two digits.
+func parseBSPhrase(fields []string, ref Time) (Time, error) {
+	if len(fields) < 2 || len(fields) > 3 {
+		return Time{}, ErrInvalidDate
+	}
+
+	var (
+		month             Month
+		day, year         = -1, ref.Year()
+		hasMonth, hasYear bool
+	)
+
+	for _, f := range fields {
+		if m, err := ParseMonth(f); err == nil && !hasMonth {
+			month, hasMonth = m, true
+			continue
+		}
+
+		n, ok := parseDigits(f)
+		if !ok {
+			return Time{}, ErrInvalidDate
+		}
+
+		switch {
+		case len([]rune(f)) > 2 && !hasYear:
+			year, hasYear = n, true
+		case len([]rune(f)) <= 2 && day == -1:
+			day = n
+		default:
+			return Time{}, ErrInvalidDate
+		}
+	}
+
+	if !hasMonth || day == -1 {
+		return Time{}, ErrInvalidDate
+	}
+
+	if !isValidBS(year, month, day) {
+		return Time{}, ErrOutOfBounds
+	}
+
+	return fromRaw(raw{year, month, day}), nil
+}
+
+// parseDigits parses a non-negative integer written in either ASCII or
+// Devanagari digits.
+func parseDigits(s string) (int, bool) {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r >= '०' && r <= '९':
+			b.WriteRune('0' + (r - '०'))
+		default:
+			return -1, false
+		}
+	}
+
+	n, err := strconv.Atoi(b.String())
+	if err != nil {
+		return -1, false
+	}
+
+	return n, true
+}
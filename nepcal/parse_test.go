@@ -0,0 +1,59 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFuzzy(t *testing.T) {
+	// Shrawan 15, 2081 is a Tuesday.
+	ref := DateUnchecked(2081, Shrawan, 15)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected Time
+	}{
+		{"today", "today", ref},
+		{"today nepali", "आज", ref},
+		{"yesterday", "Yesterday", DateUnchecked(2081, Shrawan, 14)},
+		{"tomorrow nepali", "भोलि", DateUnchecked(2081, Shrawan, 16)},
+		{"next weekday", "next friday", DateUnchecked(2081, Shrawan, 18)},
+		{"next same weekday", "next Tue", DateUnchecked(2081, Shrawan, 22)},
+		{"last weekday", "last sunday", DateUnchecked(2081, Shrawan, 13)},
+		{"nepali weekday", "next शुक्रबार", DateUnchecked(2081, Shrawan, 18)},
+		{"positive days", "+10d", DateUnchecked(2081, Shrawan, 25)},
+		{"negative days", "-15d", DateUnchecked(2081, Ashar, 31)},
+		{"weeks", "+2w", DateUnchecked(2081, Shrawan, 29)},
+		{"bs phrase", "15 Shrawan 2081", ref},
+		{"bs phrase with comma", "Shrawan 15, 2081", ref},
+		{"bs phrase devanagari", "साउन १५ २०८१", ref},
+		{"bs phrase without year", "1 baisakh", DateUnchecked(2081, Baisakh, 1)},
+		{"extra whitespace", "  next   friday ", DateUnchecked(2081, Shrawan, 18)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseFuzzy(test.input, ref)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{"", "someday", "next month", "+10x", "15 16 Shrawan", "Shrawan", "15 2081"} {
+			_, err := ParseFuzzy(input, ref)
+			assert.Equal(t, ErrInvalidDate, err, input)
+		}
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, err := ParseFuzzy("40 Shrawan 2081", ref)
+		assert.Equal(t, ErrOutOfBounds, err)
+
+		_, err = ParseFuzzy("1 Baisakh 2200", ref)
+		assert.Equal(t, ErrOutOfBounds, err)
+	})
+}
@@ -64,6 +64,23 @@ func (m Month) Name() string {
 	return v
 }
 
+// monthRomanNames are the romanised names of each month, as used for the
+// Month constants.
+var monthRomanNames = map[Month]string{
+	Baisakh:  "Baisakh",
+	Jestha:   "Jestha",
+	Ashar:    "Ashar",
+	Shrawan:  "Shrawan",
+	Bhadra:   "Bhadra",
+	Ashoj:    "Ashoj",
+	Kartik:   "Kartik",
+	Mangshir: "Mangshir",
+	Poush:    "Poush",
+	Magh:     "Magh",
+	Falgun:   "Falgun",
+	Chaitra:  "Chaitra",
+}
+
 // String implements the Stringer interface for Month.
 func (m Month) String() string {
 	return m.Name()
@@ -108,36 +125,11 @@ func (w Weekday) String() string {
 	return w.Name()
 }
 
-// Numeral represents a Nepali number.
-type Numeral int
-
-// Returns the Nepali representation of this numeral.
-func (n Numeral) String() string {
-	numerals := map[int]string{
-		0: "०",
-		1: "१",
-		2: "२",
-		3: "३",
-		4: "४",
-		5: "५",
-		6: "६",
-		7: "७",
-		8: "८",
-		9: "९",
-	}
-
-	// Recurse through the digits and create the Nepali representation.
-	repr := ""
-	curr := int(n)
-	for {
-		p := curr % 10
-		repr = numerals[p] + repr
-
-		curr = curr / 10
-		if curr == 0 {
-			break
-		}
-	}
+// Locale represents the language in which human readable strings are generated.
+type Locale int
 
-	return repr
-}
+// Supported locales.
+const (
+	Nepali Locale = iota
+	English
+)
@@ -0,0 +1,85 @@
+package nepcal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// forEachDay calls 'fn' with every day in the supported range in order, along
+// with the day before it, which is the zero Time for the first day.
+func forEachDay(fn func(prev, t Time)) {
+	var prev Time
+	for y := bsLBoundY; y <= bsUBoundY; y++ {
+		for m := Baisakh; m <= Chaitra; m++ {
+			for d := 1; d <= m.numDaysUnchecked(y); d++ {
+				t := DateUnchecked(y, m, d)
+				fn(prev, t)
+				prev = t
+			}
+		}
+	}
+}
+
+func TestPropertiesFullRange(t *testing.T) {
+	days := 0
+	glow := gregorian(adLBoundY, adLBoundM, adLBoundD)
+
+	forEachDay(func(prev, bs Time) {
+		days++
+		ad := bs.Gregorian()
+
+		// Round trip identity in both directions.
+		back, err := FromGregorian(ad)
+		if !assert.NoError(t, err) || !assert.Equal(t, bs.toRaw(), back.toRaw(), "AD %s", ad) {
+			t.FailNow()
+		}
+
+		if !assert.True(t, back.Gregorian().Equal(ad), "BS %s", bs) {
+			t.FailNow()
+		}
+
+		// Every day is one day after the previous one in both calendars.
+		if prev == (Time{}) {
+			assert.True(t, ad.Equal(glow))
+
+			return
+		}
+
+		if !assert.True(t, bs.After(prev), "BS %s after %s", bs, prev) ||
+			!assert.Equal(t, prev.JulianDay()+1, bs.JulianDay(), "BS %s", bs) ||
+			!assert.True(t, prev.Gregorian().AddDate(0, 0, 1).Equal(ad), "BS %s", bs) {
+			t.FailNow()
+		}
+
+		// Weekdays continue across months and years.
+		if !assert.Equal(t, (prev.Weekday()+1)%7, bs.Weekday(), "BS %s", bs) ||
+			!assert.Equal(t, Weekday(ad.Weekday()), bs.Weekday(), "BS %s", bs) {
+			t.FailNow()
+		}
+
+		// The number of days spanned restarts with each year, and counts up to its length.
+		expected := prev.NumDaysSpanned() + 1
+		if bs.Year() != prev.Year() {
+			if !assert.Equal(t, prev.NumDaysInYear(), prev.NumDaysSpanned(), "BS %s", prev) {
+				t.FailNow()
+			}
+
+			expected = 1
+		}
+
+		if !assert.Equal(t, expected, bs.NumDaysSpanned(), "BS %s", bs) {
+			t.FailNow()
+		}
+	})
+
+	// The range ends on the upper bounds, in both calendars.
+	upper := gregorian(adUBoundY, adUBoundM, adUBoundD)
+	assert.Equal(t, int(upper.Sub(glow).Hours()/24)+1, days)
+
+	for _, ad := range []time.Time{glow.AddDate(0, 0, -1), upper.AddDate(0, 0, 1)} {
+		_, err := FromGregorian(ad)
+		assert.Equal(t, ErrOutOfBounds, err, "AD %s", ad)
+	}
+}
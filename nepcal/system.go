@@ -0,0 +1,228 @@
+package nepcal
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CalendarSystem is implemented by every calendar that nepcal can convert
+// between. Each system maps its own (year, month, day) triples to and from a
+// Julian Day Number (JDN), which acts as the common pivot for conversions;
+// converting between any two systems is therefore a conversion into a JDN
+// followed by a conversion out of it. See the 'Convert' function.
+//
+// Months are 1-indexed in every system, i.e. the first month of the year is 1.
+type CalendarSystem interface {
+	// Name returns a short, human readable name for the calendar system.
+	Name() string
+
+	// JulianDay returns the Julian Day Number of the provided date. An
+	// ErrOutOfBounds is returned if the date is invalid or not supported.
+	JulianDay(year, month, day int) (int, error)
+
+	// FromJulianDay returns the date corresponding to the Julian Day Number.
+	// An ErrOutOfBounds is returned if the day is not supported.
+	FromJulianDay(jdn int) (year, month, day int, err error)
+
+	// MonthName returns the human readable name of the month.
+	MonthName(month int) string
+
+	// NumDaysInMonth returns the number of days in the month of the provided year.
+	// An ErrOutOfBounds is returned if the year or month is not supported.
+	NumDaysInMonth(year, month int) (int, error)
+}
+
+// Calendar systems that ship with this package.
+var (
+	// BikramSambat is the B.S. calendar system, backed by the same data
+	// as the Time struct and therefore limited to the same date range.
+	BikramSambat CalendarSystem = bikramSambat{}
+
+	// Gregorian is the proleptic Gregorian (A.D.) calendar system.
+	Gregorian CalendarSystem = gregorianSystem{}
+)
+
+// calendarSystemsMu guards calendarSystems, which may be read by concurrent
+// lookups while a system is being registered.
+var calendarSystemsMu sync.RWMutex
+
+// calendarSystems is the registry of calendar systems keyed by their lower
+// cased lookup names.
+var calendarSystems = map[string]CalendarSystem{
+	"bs":        BikramSambat,
+	"ad":        Gregorian,
+	"gregorian": Gregorian,
+}
+
+// RegisterCalendarSystem makes a calendar system available through
+// LookupCalendarSystem under the provided name. Names are case-insensitive,
+// and registering an existing name replaces the previous system. It is safe
+// to call concurrently with LookupCalendarSystem and CalendarSystemNames.
+func RegisterCalendarSystem(name string, cs CalendarSystem) {
+	calendarSystemsMu.Lock()
+	defer calendarSystemsMu.Unlock()
+
+	calendarSystems[strings.ToLower(name)] = cs
+}
+
+// LookupCalendarSystem returns the calendar system registered under the
+// provided name, such as "bs" or "ad". The boolean reports whether such a
+// system exists.
+func LookupCalendarSystem(name string) (CalendarSystem, bool) {
+	calendarSystemsMu.RLock()
+	defer calendarSystemsMu.RUnlock()
+
+	cs, ok := calendarSystems[strings.ToLower(name)]
+
+	return cs, ok
+}
+
+// CalendarSystemNames returns the sorted list of names that are currently
+// registered through RegisterCalendarSystem, including the built-in ones.
+func CalendarSystemNames() []string {
+	calendarSystemsMu.RLock()
+	defer calendarSystemsMu.RUnlock()
+
+	names := make([]string, 0, len(calendarSystems))
+	for name := range calendarSystems {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Convert converts the date represented by (year, month, day) in the 'from'
+// calendar system into the 'to' calendar system. An ErrOutOfBounds is returned
+// if the date is not supported by either of the systems.
+func Convert(from, to CalendarSystem, year, month, day int) (int, int, int, error) {
+	jdn, err := from.JulianDay(year, month, day)
+	if err != nil {
+		return -1, -1, -1, err
+	}
+
+	return to.FromJulianDay(jdn)
+}
+
+// bikramSambat implements CalendarSystem for B.S. dates.
+type bikramSambat struct{}
+
+// Name satisfies the CalendarSystem interface.
+func (bikramSambat) Name() string {
+	return "Bikram Sambat"
+}
+
+// JulianDay satisfies the CalendarSystem interface.
+func (bikramSambat) JulianDay(year, month, day int) (int, error) {
+	if !isValidBS(year, Month(month), day) {
+		return -1, ErrOutOfBounds
+	}
+
+	r := raw{year, Month(month), day}
+
+	return jdnLBound() + r.daysElapsed(), nil
+}
+
+// FromJulianDay satisfies the CalendarSystem interface.
+func (bikramSambat) FromJulianDay(jdn int) (int, int, int, error) {
+	daysElapsed := jdn - jdnLBound()
+	if daysElapsed < 0 {
+		return -1, -1, -1, ErrOutOfBounds
+	}
+
+	r := rawFromDaysElapsed(daysElapsed)
+	if r.year == -1 {
+		return -1, -1, -1, ErrOutOfBounds
+	}
+
+	return r.year, int(r.month), r.day, nil
+}
+
+// MonthName satisfies the CalendarSystem interface.
+func (bikramSambat) MonthName(month int) string {
+	return Month(month).Name()
+}
+
+// NumDaysInMonth satisfies the CalendarSystem interface.
+func (bikramSambat) NumDaysInMonth(year, month int) (int, error) {
+	if month < 1 || month > 12 {
+		return -1, ErrOutOfBounds
+	}
+
+	return Month(month).NumDays(year)
+}
+
+// gregorianSystem implements CalendarSystem for Gregorian dates.
+type gregorianSystem struct{}
+
+// Name satisfies the CalendarSystem interface.
+func (gregorianSystem) Name() string {
+	return "Gregorian"
+}
+
+// JulianDay satisfies the CalendarSystem interface.
+func (g gregorianSystem) JulianDay(year, month, day int) (int, error) {
+	days, err := g.NumDaysInMonth(year, month)
+	if err != nil || day < 1 || day > days {
+		return -1, ErrOutOfBounds
+	}
+
+	return gregorianToJDN(year, month, day), nil
+}
+
+// FromJulianDay satisfies the CalendarSystem interface.
+func (gregorianSystem) FromJulianDay(jdn int) (int, int, int, error) {
+	year, month, day := jdnToGregorian(jdn)
+
+	return year, month, day, nil
+}
+
+// MonthName satisfies the CalendarSystem interface.
+func (gregorianSystem) MonthName(month int) string {
+	return time.Month(month).String()
+}
+
+// NumDaysInMonth satisfies the CalendarSystem interface.
+func (gregorianSystem) NumDaysInMonth(year, month int) (int, error) {
+	if month < 1 || month > 12 {
+		return -1, ErrOutOfBounds
+	}
+
+	// Day 0 of the next month normalizes to the last day of this month.
+	return gregorian(year, month+1, 0).Day(), nil
+}
+
+// jdnLBound returns the Julian Day Number of the lower bound dates, which is
+// the same day in both A.D. and B.S.
+func jdnLBound() int {
+	return gregorianToJDN(adLBoundY, adLBoundM, adLBoundD)
+}
+
+// gregorianToJDN computes the Julian Day Number for a Gregorian date using the
+// integer arithmetic algorithm from Fliegel & Van Flandern.
+func gregorianToJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// jdnToGregorian is the inverse of gregorianToJDN.
+func jdnToGregorian(jdn int) (int, int, int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+
+	return year, month, day
+}
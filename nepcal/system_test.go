@@ -0,0 +1,123 @@
+package nepcal
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGregorianJDN(t *testing.T) {
+	// Well known reference points.
+	assert.Equal(t, 2451545, gregorianToJDN(2000, 1, 1))
+	assert.Equal(t, 2440588, gregorianToJDN(1970, 1, 1))
+
+	y, m, d := jdnToGregorian(2451545)
+	assert.Equal(t, 2000, y)
+	assert.Equal(t, 1, m)
+	assert.Equal(t, 1, d)
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name       string
+		from, to   CalendarSystem
+		y, m, d    int
+		ey, em, ed int
+	}{
+		{"ad to bs", Gregorian, BikramSambat, 1994, 8, 21, 2051, 5, 5},
+		{"bs to ad", BikramSambat, Gregorian, 2053, 8, 18, 1996, 12, 3},
+		{"lower bound", Gregorian, BikramSambat, adLBoundY, adLBoundM, adLBoundD, bsLBoundY, bsLBoundM, bsLBoundD},
+		{"upper bound", BikramSambat, Gregorian, bsUBoundY, bsUBoundM, bsUBoundD, adUBoundY, adUBoundM, adUBoundD},
+		{"identity", BikramSambat, BikramSambat, 2076, 2, 32, 2076, 2, 32},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			y, m, d, err := Convert(test.from, test.to, test.y, test.m, test.d)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.ey, y)
+			assert.Equal(t, test.em, m)
+			assert.Equal(t, test.ed, d)
+		})
+	}
+
+	t.Run("agrees with Time", func(t *testing.T) {
+		g := gregorian(2018, 5, 17)
+		bs := FromGregorianUnchecked(g)
+
+		y, m, d, err := Convert(Gregorian, BikramSambat, 2018, 5, 17)
+		assert.NoError(t, err)
+		assert.Equal(t, bs.Year(), y)
+		assert.Equal(t, bs.Month(), Month(m))
+		assert.Equal(t, bs.Day(), d)
+	})
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, _, _, err := Convert(BikramSambat, Gregorian, 2076, 1, 32)
+		assert.Equal(t, ErrOutOfBounds, err)
+
+		_, _, _, err = Convert(Gregorian, BikramSambat, 1900, 1, 1)
+		assert.Equal(t, ErrOutOfBounds, err)
+
+		_, _, _, err = Convert(Gregorian, BikramSambat, 2019, 2, 29)
+		assert.Equal(t, ErrOutOfBounds, err)
+
+		_, _, _, err = Convert(Gregorian, BikramSambat, adUBoundY, adUBoundM, adUBoundD+1)
+		assert.Equal(t, ErrOutOfBounds, err)
+	})
+}
+
+func TestCalendarSystemMetadata(t *testing.T) {
+	assert.Equal(t, "जेठ", BikramSambat.MonthName(2))
+	assert.Equal(t, time.February.String(), Gregorian.MonthName(2))
+
+	n, err := BikramSambat.NumDaysInMonth(2076, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 32, n)
+
+	n, err = Gregorian.NumDaysInMonth(2020, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 29, n)
+
+	_, err = Gregorian.NumDaysInMonth(2020, 13)
+	assert.Equal(t, ErrOutOfBounds, err)
+}
+
+func TestLookupCalendarSystem(t *testing.T) {
+	cs, ok := LookupCalendarSystem("BS")
+	assert.True(t, ok)
+	assert.Equal(t, BikramSambat, cs)
+
+	cs, ok = LookupCalendarSystem("ad")
+	assert.True(t, ok)
+	assert.Equal(t, Gregorian, cs)
+
+	_, ok = LookupCalendarSystem("ns")
+	assert.False(t, ok)
+
+	assert.Contains(t, CalendarSystemNames(), "bs")
+}
+
+func TestRegisterCalendarSystemConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterCalendarSystem("Vikram", BikramSambat)
+		}()
+		go func() {
+			defer wg.Done()
+			LookupCalendarSystem("vikram")
+			CalendarSystemNames()
+		}()
+	}
+	wg.Wait()
+
+	cs, ok := LookupCalendarSystem("vikram")
+	assert.True(t, ok)
+	assert.Equal(t, BikramSambat, cs)
+}
@@ -0,0 +1,242 @@
+package nepcal
+
+import "strings"
+
+// Scheme is a romanisation scheme used to transliterate Devanagari text.
+type Scheme int
+
+// Supported transliteration schemes.
+const (
+	// IAST is the International Alphabet of Sanskrit Transliteration, e.g. "baiśākha".
+	IAST Scheme = iota
+
+	// ISO15919 is the ISO 15919 standard, which differs from IAST for a few
+	// vowels and signs, e.g. "ē" for ए and "ṁ" for the anusvara.
+	ISO15919
+
+	// ASCII is a simple, lossy romanisation using only ASCII characters that
+	// follows common Nepali spellings, e.g. "baishakh". Unlike the other
+	// schemes, the inherent 'a' is dropped where it is not pronounced.
+	ASCII
+)
+
+// Transliterate romanises every Devanagari character in 's' using the provided
+// scheme, leaving all other characters as they are. Devanagari digits are
+// always converted to ASCII digits. The output is deterministic, which means
+// that every Devanagari string this package emits, such as Month.Name, Time.String
+// or Humanize, has a stable romanised counterpart.
+func Transliterate(s string, scheme Scheme) string {
+	table := transliterationTables[scheme]
+
+	var b strings.Builder
+	var word []akshara
+
+	flush := func() {
+		if table.deleteSchwa {
+			deleteSchwa(word)
+		}
+
+		for _, a := range word {
+			b.WriteString(a.String())
+		}
+
+		word = word[:0]
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case table.consonants[r] != "":
+			a := akshara{consonant: table.consonants[r], vowel: table.inherent, inherent: true}
+
+			// Skip the nukta; it does not change the romanisation.
+			if i+1 < len(runes) && runes[i+1] == nukta {
+				i++
+			}
+
+			if i+1 < len(runes) {
+				if next := runes[i+1]; next == virama {
+					a.vowel, a.inherent, a.virama = "", false, true
+					i++
+				} else if table.matras[next] != "" {
+					a.vowel, a.inherent = table.matras[next], false
+					i++
+				}
+			}
+
+			word = append(word, a)
+		case table.vowels[r] != "":
+			word = append(word, akshara{vowel: table.vowels[r]})
+		case strings.ContainsRune("ंँः", r) && len(word) > 0:
+			// Signs modifying the preceding syllable.
+			word[len(word)-1].suffix += table.signs[r]
+		case r >= '०' && r <= '९':
+			flush()
+			b.WriteRune('0' + (r - '०'))
+		case r == '।' || r == '॥':
+			flush()
+			b.WriteRune('.')
+		case r == zwj || r == zwnj || r == nukta:
+			// Formatting characters with no romanisation.
+		default:
+			flush()
+			b.WriteString(table.signs[r])
+			if table.signs[r] == "" {
+				b.WriteRune(r)
+			}
+		}
+	}
+
+	flush()
+
+	return b.String()
+}
+
+// Transliterate is a convenience method that transliterates the name of this month.
+func (m Month) Transliterate(scheme Scheme) string {
+	return Transliterate(m.Name(), scheme)
+}
+
+// Transliterate is a convenience method that transliterates the name of this weekday.
+func (w Weekday) Transliterate(scheme Scheme) string {
+	return Transliterate(w.Name(), scheme)
+}
+
+// Special Devanagari characters.
+const (
+	virama = '्'
+	nukta  = '़'
+	zwj    = '\u200d'
+	zwnj   = '\u200c'
+)
+
+// An akshara is a single Devanagari syllable: an optional consonant, a vowel
+// and any signs like the anusvara following them.
+type akshara struct {
+	consonant, vowel, suffix string
+
+	// Whether the vowel is the inherent 'a' of the consonant, or the
+	// consonant is followed by a virama and has no vowel at all.
+	inherent, virama bool
+}
+
+func (a akshara) String() string {
+	return a.consonant + a.vowel + a.suffix
+}
+
+// hasVowel reports if the syllable is pronounced with a vowel.
+func (a akshara) hasVowel() bool {
+	return a.vowel != ""
+}
+
+// deleteSchwa removes the inherent 'a' from the syllables of a word where it
+// is not pronounced in Nepali, using the following rules:
+//  1. The final inherent 'a' of a word is dropped unless the word is a single
+//     syllable or ends in a conjunct, e.g. "बैशाख" is "baishakh" but "पन्ध्र" is "pandhra".
+//  2. Going right to left, an inherent 'a' is dropped from a syllable that is
+//     between two vowel bearing syllables, e.g. "सोमबार" is "sombar".
+func deleteSchwa(word []akshara) {
+	n := len(word)
+	if n < 2 {
+		return
+	}
+
+	if last := word[n-1]; last.inherent && last.suffix == "" && !word[n-2].virama {
+		word[n-1].vowel = ""
+	}
+
+	for i := n - 2; i > 0; i-- {
+		curr := word[i]
+		if !curr.inherent || curr.suffix != "" {
+			continue
+		}
+
+		if word[i-1].hasVowel() && word[i+1].consonant != "" && word[i+1].hasVowel() {
+			word[i].vowel = ""
+		}
+	}
+}
+
+// A transliteration table maps each kind of Devanagari character to its
+// romanisation in a scheme.
+type transliterationTable struct {
+	consonants, vowels, matras, signs map[rune]string
+
+	// The romanisation of the inherent vowel of consonants.
+	inherent string
+
+	// Whether unpronounced inherent vowels are dropped.
+	deleteSchwa bool
+}
+
+// transliterationTables holds the table for each supported scheme.
+var transliterationTables = map[Scheme]transliterationTable{
+	IAST: {
+		consonants: iastConsonants,
+		vowels:     iastVowels,
+		matras:     iastMatras,
+		signs:      map[rune]string{'ं': "ṃ", 'ँ': "m̐", 'ः': "ḥ", 'ऽ': "'", 'ॐ': "oṃ"},
+		inherent:   "a",
+	},
+	ISO15919: {
+		consonants: iastConsonants,
+		vowels:     override(iastVowels, map[rune]string{'ऋ': "r̥", 'ए': "ē", 'ओ': "ō"}),
+		matras:     override(iastMatras, map[rune]string{'ृ': "r̥", 'े': "ē", 'ो': "ō"}),
+		signs:      map[rune]string{'ं': "ṁ", 'ँ': "m̐", 'ः': "ḥ", 'ऽ': "'", 'ॐ': "ōṁ"},
+		inherent:   "a",
+	},
+	ASCII: {
+		consonants: override(iastConsonants, map[rune]string{
+			'ङ': "ng", 'च': "ch", 'छ': "chh", 'ञ': "n",
+			'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+			'श': "sh", 'ष': "sh",
+		}),
+		vowels: map[rune]string{
+			'अ': "a", 'आ': "a", 'इ': "i", 'ई': "i", 'उ': "u", 'ऊ': "u",
+			'ऋ': "ri", 'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+		},
+		matras: map[rune]string{
+			'ा': "a", 'ि': "i", 'ी': "i", 'ु': "u", 'ू': "u",
+			'ृ': "ri", 'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+		},
+		signs:       map[rune]string{'ं': "n", 'ँ': "n", 'ः': "h", 'ऽ': "'", 'ॐ': "om"},
+		inherent:    "a",
+		deleteSchwa: true,
+	},
+}
+
+var iastConsonants = map[rune]string{
+	'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "ṅ",
+	'च': "c", 'छ': "ch", 'ज': "j", 'झ': "jh", 'ञ': "ñ",
+	'ट': "ṭ", 'ठ': "ṭh", 'ड': "ḍ", 'ढ': "ḍh", 'ण': "ṇ",
+	'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+	'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+	'य': "y", 'र': "r", 'ल': "l", 'व': "v",
+	'श': "ś", 'ष': "ṣ", 'स': "s", 'ह': "h",
+}
+
+var iastVowels = map[rune]string{
+	'अ': "a", 'आ': "ā", 'इ': "i", 'ई': "ī", 'उ': "u", 'ऊ': "ū",
+	'ऋ': "ṛ", 'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+}
+
+var iastMatras = map[rune]string{
+	'ा': "ā", 'ि': "i", 'ी': "ī", 'ु': "u", 'ू': "ū",
+	'ृ': "ṛ", 'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+}
+
+// override returns a copy of 'base' with the entries in 'with' replacing its own.
+func override(base, with map[rune]string) map[rune]string {
+	m := make(map[rune]string, len(base))
+	for k, v := range base {
+		m[k] = v
+	}
+
+	for k, v := range with {
+		m[k] = v
+	}
+
+	return m
+}
@@ -0,0 +1,80 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		input string
+		iast  string
+		iso   string
+		ascii string
+	}{
+		{"बैशाख", "baiśākha", "baiśākha", "baishakh"},
+		{"जेठ", "jeṭha", "jēṭha", "jeth"},
+		{"मंसिर", "maṃsira", "maṁsira", "mansir"},
+		{"सोमबार", "somabāra", "sōmabāra", "sombar"},
+		{"शुक्रबार", "śukrabāra", "śukrabāra", "shukrabar"},
+		{"पन्ध्र", "pandhra", "pandhra", "pandhra"},
+		{"पाँच", "pām̐ca", "pām̐ca", "panch"},
+		{"२०८१", "2081", "2081", "2081"},
+		{"साउन १५, २०८१ मंगलबार", "sāuna 15, 2081 maṃgalabāra", "sāuna 15, 2081 maṁgalabāra", "saun 15, 2081 mangalbar"},
+		{"today है।", "today hai.", "today hai.", "today hai."},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ascii, func(t *testing.T) {
+			assert.Equal(t, test.iast, Transliterate(test.input, IAST))
+			assert.Equal(t, test.iso, Transliterate(test.input, ISO15919))
+			assert.Equal(t, test.ascii, Transliterate(test.input, ASCII))
+		})
+	}
+}
+
+func TestTransliterateIsASCII(t *testing.T) {
+	isASCII := func(s string) bool {
+		for _, r := range s {
+			if r > 127 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	var emitted []string
+	for m := Baisakh; m <= Chaitra; m++ {
+		emitted = append(emitted, m.Name())
+	}
+
+	for w := Sunday; w <= Saturday; w++ {
+		emitted = append(emitted, w.Name())
+	}
+
+	now := DateUnchecked(2081, Shrawan, 15)
+	amount, err := AmountWords(987654321, 99, Nepali)
+	assert.NoError(t, err)
+	emitted = append(emitted,
+		now.String(),
+		now.Words(),
+		Numeral(-1234567).Grouped(),
+		Humanize(DateUnchecked(2079, Shrawan, 15), now, Nepali),
+		Humanize(DateUnchecked(2081, Ashoj, 20), now, Nepali),
+		amount,
+	)
+
+	for _, s := range emitted {
+		ascii := Transliterate(s, ASCII)
+		assert.True(t, isASCII(ascii), "%s => %s", s, ascii)
+		assert.Equal(t, ascii, Transliterate(s, ASCII), "should be deterministic")
+	}
+}
+
+func TestMonthWeekdayTransliterate(t *testing.T) {
+	assert.Equal(t, "chait", Chaitra.Transliterate(ASCII))
+	assert.Equal(t, "aitbar", Sunday.Transliterate(ASCII))
+	assert.Equal(t, "āitabāra", Sunday.Transliterate(IAST))
+}
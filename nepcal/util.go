@@ -5,23 +5,23 @@ import (
 	"time"
 )
 
-// IsInRangeGregorian checks if 't' is after 04/14/1943.
+// IsInRangeGregorian checks if the date of 't' is within the supported range,
+// from 04/13/1918 to 04/12/2044. Only the date is considered, in the time zone of 't'.
 func IsInRangeGregorian(t time.Time) bool {
 	adLBound := gregorian(adLBoundY, adLBoundM, adLBoundD)
+	adUBound := gregorian(adUBoundY, adUBoundM, adUBoundD)
 
-	return t.Equal(adLBound) || t.After(adLBound)
+	y, m, d := t.Date()
+	g := gregorian(y, int(m), d)
+
+	return !g.Before(adLBound) && !g.After(adUBound)
 }
 
 // IsInRangeBS checks if the provided date represents a B.S. that
 // we have data for and can be supported for conversions to/from A.D.
+// Days that do not exist, such as the 32nd of a 31 day month, are not in range.
 func IsInRangeBS(year int, month Month, day int) bool {
-	// Lower bound raw date.
-	bslow := raw{bsLBoundY, bsLBoundM, bsLBoundD}
-
-	// Input raw date.
-	inraw := raw{year, month, day}
-
-	return after(inraw, bslow)
+	return isValidBS(year, month, day)
 }
 
 // IsInRangeYear return true if the provided bsYear is within the supported
@@ -30,6 +30,16 @@ func IsInRangeYear(bsYear int) bool {
 	return bsYear >= bsLBoundY && bsYear <= bsUBoundY
 }
 
+// isValidBS checks that the provided date exists in the B.S. data, i.e. the year
+// is in range and the month and day are valid for that year.
+func isValidBS(year int, month Month, day int) bool {
+	if !IsInRangeYear(year) || month < Baisakh || month > Chaitra {
+		return false
+	}
+
+	return day >= 1 && day <= month.numDaysUnchecked(year)
+}
+
 // gregorian creates a new time.Time with the basic yy/mm/dd parameters.
 // Crucially, the time returned is in UTC.
 func gregorian(yy, mm, dd int) time.Time {
@@ -0,0 +1,139 @@
+package nepcal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidDataTable is the error returned when a data table is inconsistent.
+// It is wrapped along with a description of the first inconsistency found.
+var ErrInvalidDataTable = errors.New("Invalid B.S. data table")
+
+// knownShortYears are the B.S. years that are known to have fewer days in the
+// data table than a real year, along with their length in the table. The
+// fixtures that the table is generated from have 364 days in 2096, which is a
+// known error awaiting a correction in the reference data; conversions of dates
+// in and after that year may be a day off until then. Only the length of these
+// years is exempt from validation, and only for exactly the length listed, so
+// an entry has to be removed once the year is corrected.
+var knownShortYears = map[int]int{
+	2096: 364,
+}
+
+// DataTable is the data that B.S. dates are computed from: the number of days in
+// each month of every supported year, and the bounds of the supported range in
+// both calendars.
+type DataTable struct {
+	// DaysInMonths maps each B.S. year to the number of days in its 12 months.
+	DaysInMonths map[int][]int
+
+	// The first and last supported B.S. dates, as year, month and day.
+	BSLower, BSUpper [3]int
+
+	// The A.D. dates of BSLower and BSUpper.
+	ADLower, ADUpper time.Time
+}
+
+// BuiltinDataTable returns a copy of the data table compiled into the package.
+func BuiltinDataTable() DataTable {
+	days := make(map[int][]int, len(bsDaysInMonthsByYear))
+	for y, months := range bsDaysInMonthsByYear {
+		days[y] = append([]int{}, months...)
+	}
+
+	return DataTable{
+		DaysInMonths: days,
+		BSLower:      [3]int{bsLBoundY, bsLBoundM, bsLBoundD},
+		BSUpper:      [3]int{bsUBoundY, bsUBoundM, bsUBoundD},
+		ADLower:      gregorian(adLBoundY, adLBoundM, adLBoundD),
+		ADUpper:      gregorian(adUBoundY, adUBoundM, adUBoundD),
+	}
+}
+
+// Validate checks that the data table compiled into the package is consistent.
+// The package panics on initialization if it is not, so this only returns an
+// error if the package was built with a modified table and the check removed.
+func Validate() error {
+	return BuiltinDataTable().Validate()
+}
+
+// Validate checks that the data table is consistent:
+//  1. Every year from the lower to the upper bound is present, and no others.
+//  2. Every year has 12 months of 29 to 32 days, which sum to 365 or 366 days,
+//     or to the length listed for the known short years such as 2096.
+//  3. The B.S. bounds are the first and last days of the table.
+//  4. The A.D. bounds are as many days apart as there are days in the table.
+//
+// The returned error wraps ErrInvalidDataTable.
+func (d DataTable) Validate() error {
+	invalid := func(format string, a ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidDataTable, fmt.Sprintf(format, a...))
+	}
+
+	first, last := d.BSLower[0], d.BSUpper[0]
+	if last < first {
+		return invalid("the upper bound %d is before the lower bound %d", last, first)
+	}
+
+	if len(d.DaysInMonths) != last-first+1 {
+		return invalid("%d years for the range %d to %d", len(d.DaysInMonths), first, last)
+	}
+
+	total := 0
+	for y := first; y <= last; y++ {
+		months, ok := d.DaysInMonths[y]
+		if !ok {
+			return invalid("missing year %d", y)
+		}
+
+		if len(months) != 12 {
+			return invalid("%d has %d months", y, len(months))
+		}
+
+		sum := 0
+		for m, days := range months {
+			if days < 29 || days > 32 {
+				return invalid("%d-%02d has %d days", y, m+1, days)
+			}
+
+			sum += days
+		}
+
+		if length, ok := knownShortYears[y]; ok {
+			if sum != length {
+				return invalid("%d has %d days, but is known to have %d", y, sum, length)
+			}
+		} else if sum != 365 && sum != 366 {
+			return invalid("%d has %d days", y, sum)
+		}
+
+		total += sum
+	}
+
+	if d.BSLower != [3]int{first, 1, 1} {
+		return invalid("the lower bound %d-%02d-%02d is not the first day of %d", d.BSLower[0], d.BSLower[1], d.BSLower[2], first)
+	}
+
+	if lastDay := d.DaysInMonths[last][11]; d.BSUpper != [3]int{last, 12, lastDay} {
+		return invalid("the upper bound %d-%02d-%02d is not the last day of %d", d.BSUpper[0], d.BSUpper[1], d.BSUpper[2], last)
+	}
+
+	// Compare the dates only, regardless of the time zones.
+	lower := gregorian(d.ADLower.Year(), int(d.ADLower.Month()), d.ADLower.Day())
+	upper := gregorian(d.ADUpper.Year(), int(d.ADUpper.Month()), d.ADUpper.Day())
+
+	if span := int(upper.Sub(lower).Hours()/24) + 1; span != total {
+		return invalid("the A.D. bounds %s to %s span %d days, but the table has %d", lower.Format("2006-01-02"), upper.Format("2006-01-02"), span, total)
+	}
+
+	return nil
+}
+
+// The conversions silently produce wrong dates from an inconsistent table, so
+// refuse to work with one at all.
+func init() {
+	if err := Validate(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,123 @@
+package nepcal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate())
+}
+
+func TestBuiltinDataTable(t *testing.T) {
+	table := BuiltinDataTable()
+	assert.Len(t, table.DaysInMonths, bsUBoundY-bsLBoundY+1)
+
+	// The table is a copy that can be modified.
+	table.DaysInMonths[2081][0] = 29
+	n, _ := Baisakh.NumDays(2081)
+	assert.Equal(t, 31, n)
+}
+
+func TestDataTableValidate(t *testing.T) {
+	// A copy of the builtin table for the first two years, which can be modified.
+	table := func() DataTable {
+		return DataTable{
+			DaysInMonths: map[int][]int{
+				1975: append([]int{}, bsDaysInMonthsByYear[1975]...),
+				1976: append([]int{}, bsDaysInMonthsByYear[1976]...),
+			},
+			BSLower: [3]int{1975, 1, 1},
+			BSUpper: [3]int{1976, 12, 31},
+			ADLower: gregorian(1918, 4, 13),
+			ADUpper: gregorian(1920, 4, 12),
+		}
+	}
+
+	// Replaces the table with a copy of the builtin B.S. 2096, a known short year.
+	only2096 := func(d *DataTable) {
+		lower := DateUnchecked(2096, Baisakh, 1).Gregorian()
+
+		*d = DataTable{
+			DaysInMonths: map[int][]int{2096: append([]int{}, bsDaysInMonthsByYear[2096]...)},
+			BSLower:      [3]int{2096, 1, 1},
+			BSUpper:      [3]int{2096, 12, 30},
+			ADLower:      lower,
+			ADUpper:      lower.AddDate(0, 0, 363),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		change   func(*DataTable)
+		expected string
+	}{
+		{"valid", func(*DataTable) {}, ""},
+		{"A.D. bounds in another time zone", func(d *DataTable) {
+			d.ADLower = time.Date(1918, 4, 13, 23, 0, 0, 0, NepalTime)
+		}, ""},
+		{"missing year", func(d *DataTable) {
+			d.DaysInMonths[1974] = d.DaysInMonths[1976]
+			delete(d.DaysInMonths, 1976)
+		}, "missing year 1976"},
+		{"extra year", func(d *DataTable) {
+			d.DaysInMonths[1977] = d.DaysInMonths[1976]
+		}, "3 years for the range 1975 to 1976"},
+		{"missing month", func(d *DataTable) {
+			d.DaysInMonths[1976] = d.DaysInMonths[1976][:11]
+		}, "1976 has 11 months"},
+		{"short month", func(d *DataTable) {
+			d.DaysInMonths[1975][1] = 28
+		}, "1975-02 has 28 days"},
+		{"short year", func(d *DataTable) {
+			d.DaysInMonths[1975][2] = 31
+		}, "1975 has 364 days"},
+		{"known short year", only2096, ""},
+		{"known short year with another length", func(d *DataTable) {
+			only2096(d)
+			d.DaysInMonths[2096][11] = 31
+			d.BSUpper = [3]int{2096, 12, 31}
+			d.ADUpper = d.ADUpper.AddDate(0, 0, 1)
+		}, "2096 has 365 days, but is known to have 364"},
+		{"known short year with a short month", func(d *DataTable) {
+			only2096(d)
+			d.DaysInMonths[2096][1] = 28
+		}, "2096-02 has 28 days"},
+		{"known short year with disagreeing A.D. bounds", func(d *DataTable) {
+			only2096(d)
+			d.ADUpper = d.ADUpper.AddDate(0, 0, 1)
+		}, "the A.D. bounds 2039-04-15 to 2040-04-13 span 365 days, but the table has 364"},
+		{"upper bound before lower bound", func(d *DataTable) {
+			d.BSUpper = [3]int{1974, 12, 30}
+		}, "the upper bound 1974 is before the lower bound 1975"},
+		{"lower bound within a year", func(d *DataTable) {
+			d.BSLower = [3]int{1975, 1, 2}
+		}, "the lower bound 1975-01-02 is not the first day of 1975"},
+		{"upper bound within a year", func(d *DataTable) {
+			d.BSUpper = [3]int{1976, 12, 30}
+		}, "the upper bound 1976-12-30 is not the last day of 1976"},
+		{"A.D. bounds disagree", func(d *DataTable) {
+			d.ADUpper = gregorian(1920, 4, 13)
+		}, "the A.D. bounds 1918-04-13 to 1920-04-13 span 732 days, but the table has 731"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := table()
+			test.change(&d)
+
+			err := d.Validate()
+			if test.expected == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.True(t, errors.Is(err, ErrInvalidDataTable))
+			assert.EqualError(t, err, "Invalid B.S. data table: "+test.expected)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package nepcal
+
+// AddDays returns the date 'n' days after this date; 'n' may be negative
+// to go back in time. An ErrOutOfBounds is returned if the resulting date is
+// outside the supported date range.
+func (t Time) AddDays(n int) (Time, error) {
+	return FromJulianDay(t.JulianDay() + n)
+}
+
+// WeekOfMonth returns the 1-indexed week of the B.S. month that this date falls in.
+// Weeks start on the 'first' weekday, which is Sunday as per the standard in Nepal,
+// and the first week of the month is the (possibly partial) week containing the 1st.
+func (t Time) WeekOfMonth(first Weekday) int {
+	return weekNumber(t.StartWeekday(), first, t.day)
+}
+
+// WeekOfYear returns the 1-indexed week of the B.S. year that this date falls in.
+// Weeks start on the 'first' weekday, which is Sunday as per the standard in Nepal,
+// and the first week of the year is the (possibly partial) week containing Baisakh 1.
+func (t Time) WeekOfYear(first Weekday) int {
+	dayOfYear := t.NumDaysSpanned()
+	startOfYear := Weekday(mod(int(t.Weekday())-(dayOfYear-1), 7))
+
+	return weekNumber(startOfYear, first, dayOfYear)
+}
+
+// StartOfWeek returns the first day of the week that this date falls in, where
+// weeks start on the 'first' weekday. An ErrOutOfBounds is returned if that
+// day is outside the supported date range.
+func (t Time) StartOfWeek(first Weekday) (Time, error) {
+	return t.AddDays(-daysBetween(first, t.Weekday()))
+}
+
+// EndOfWeek returns the last day of the week that this date falls in, where
+// weeks start on the 'first' weekday. An ErrOutOfBounds is returned if that
+// day is outside the supported date range.
+func (t Time) EndOfWeek(first Weekday) (Time, error) {
+	return t.AddDays(6 - daysBetween(first, t.Weekday()))
+}
+
+// Next returns the first date strictly after this date that falls on the weekday 'w',
+// e.g. "next Saturday". An ErrOutOfBounds is returned if that date is outside the
+// supported date range.
+func (t Time) Next(w Weekday) (Time, error) {
+	n := daysBetween(t.Weekday(), w)
+	if n == 0 {
+		n = 7
+	}
+
+	return t.AddDays(n)
+}
+
+// Previous returns the last date strictly before this date that falls on the weekday 'w',
+// e.g. "last Saturday". An ErrOutOfBounds is returned if that date is outside the
+// supported date range.
+func (t Time) Previous(w Weekday) (Time, error) {
+	n := daysBetween(w, t.Weekday())
+	if n == 0 {
+		n = 7
+	}
+
+	return t.AddDays(-n)
+}
+
+// weekNumber computes the 1-indexed week that the 1-indexed 'day' of a period
+// falls in, given the weekday on which the period starts and the weekday on
+// which weeks start.
+func weekNumber(periodStart, first Weekday, day int) int {
+	offset := daysBetween(first, periodStart)
+
+	return (day-1+offset)/7 + 1
+}
+
+// daysBetween returns the number of days (0-6) from weekday 'from' going
+// forwards until weekday 'to'.
+func daysBetween(from, to Weekday) int {
+	return mod(int(to)-int(from), 7)
+}
+
+// mod is the modulo operation that, unlike '%', is never negative for positive 'n'.
+func mod(a, n int) int {
+	return ((a % n) + n) % n
+}
@@ -0,0 +1,108 @@
+package nepcal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddDays(t *testing.T) {
+	bs := DateUnchecked(2076, Jestha, 31)
+
+	next, err := bs.AddDays(2)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2076, Ashar, 1), next)
+
+	prev, err := bs.AddDays(-31)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2076, Baisakh, 31), prev)
+
+	_, err = DateUnchecked(bsUBoundY, bsUBoundM, bsUBoundD).AddDays(1)
+	assert.Equal(t, ErrOutOfBounds, err)
+}
+
+func TestWeekOfMonth(t *testing.T) {
+	// Jestha 2075 starts on a Tuesday.
+	tests := []struct {
+		name     string
+		date     Time
+		first    Weekday
+		expected int
+	}{
+		{"first day", DateUnchecked(2075, Jestha, 1), Sunday, 1},
+		{"last day of first week", DateUnchecked(2075, Jestha, 5), Sunday, 1},
+		{"first sunday", DateUnchecked(2075, Jestha, 6), Sunday, 2},
+		{"last day", DateUnchecked(2075, Jestha, 31), Sunday, 5},
+		{"sunday with monday start", DateUnchecked(2075, Jestha, 6), Monday, 1},
+		{"monday with monday start", DateUnchecked(2075, Jestha, 7), Monday, 2},
+		{"tuesday start", DateUnchecked(2075, Jestha, 8), Tuesday, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.date.WeekOfMonth(test.first))
+		})
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	// Baisakh 1, 2075 is a Saturday.
+	tests := []struct {
+		name     string
+		date     Time
+		first    Weekday
+		expected int
+	}{
+		{"first day", DateUnchecked(2075, Baisakh, 1), Sunday, 1},
+		{"first sunday", DateUnchecked(2075, Baisakh, 2), Sunday, 2},
+		{"saturday start", DateUnchecked(2075, Baisakh, 7), Saturday, 1},
+		{"second month", DateUnchecked(2075, Jestha, 6), Sunday, 7},
+		{"last day", DateUnchecked(2075, Chaitra, 30), Sunday, 53},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.date.WeekOfYear(test.first))
+		})
+	}
+}
+
+func TestStartEndOfWeek(t *testing.T) {
+	// Jestha 3, 2075 is a Thursday.
+	bs := DateUnchecked(2075, Jestha, 3)
+
+	start, err := bs.StartOfWeek(Sunday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Baisakh, 30), start)
+	assert.Equal(t, Sunday, start.Weekday())
+
+	end, err := bs.EndOfWeek(Sunday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Jestha, 5), end)
+	assert.Equal(t, Saturday, end.Weekday())
+
+	start, err = bs.StartOfWeek(Thursday)
+	assert.NoError(t, err)
+	assert.Equal(t, bs, start)
+}
+
+func TestNextPrevious(t *testing.T) {
+	// Jestha 3, 2075 is a Thursday.
+	bs := DateUnchecked(2075, Jestha, 3)
+
+	next, err := bs.Next(Saturday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Jestha, 5), next)
+
+	next, err = bs.Next(Thursday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Jestha, 10), next)
+
+	prev, err := bs.Previous(Friday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Baisakh, 28), prev)
+
+	prev, err = bs.Previous(Thursday)
+	assert.NoError(t, err)
+	assert.Equal(t, DateUnchecked(2075, Baisakh, 27), prev)
+}
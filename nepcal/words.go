@@ -0,0 +1,219 @@
+package nepcal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidAmount is returned when an amount of money cannot be spelled out.
+var ErrInvalidAmount = errors.New("Amount must have non-negative rupees and between 0 and 99 paisa")
+
+// Words returns this numeral spelled out in Nepali words, using the Nepali
+// numbering scales (हजार, लाख, करोड, अर्ब and so on). For example, 2081
+// is "दुई हजार एकासी".
+func (n Numeral) Words() string {
+	return n.WordsIn(Nepali)
+}
+
+// WordsIn returns this numeral spelled out in words for the provided locale.
+// The English words also use the Nepali numbering scales as is customary on
+// cheques and documents in Nepal, e.g. 250000 is "two lakh fifty thousand".
+func (n Numeral) WordsIn(locale Locale) string {
+	w := numberWords[locale]
+
+	if n == 0 {
+		return w.small[0]
+	}
+
+	// Work with unsigned values so that the minimum int can be negated.
+	u := uint64(n)
+	prefix := ""
+	if n < 0 {
+		u = -u
+		prefix = w.negative + " "
+	}
+
+	return prefix + spell(u, w)
+}
+
+// AmountWords spells out an amount of money in rupees and paisa for the
+// provided locale, suitable for cheques and legal documents. For example,
+// 2000 rupees and 50 paisa is "दुई हजार रुपैयाँ पचास पैसा मात्र" in Nepali
+// and "two thousand rupees and fifty paisa only" in English. The paisa are
+// omitted if zero. An ErrInvalidAmount is returned if the rupees are negative
+// or the paisa are not between 0 and 99.
+func AmountWords(rupees, paisa int, locale Locale) (string, error) {
+	if rupees < 0 || paisa < 0 || paisa > 99 {
+		return "", ErrInvalidAmount
+	}
+
+	w := numberWords[locale]
+
+	s := fmt.Sprintf("%s %s", Numeral(rupees).WordsIn(locale), w.rupees)
+	if paisa != 0 {
+		s = fmt.Sprintf("%s%s %s %s", s, w.and, Numeral(paisa).WordsIn(locale), w.paisa)
+	}
+
+	return s + " " + w.only, nil
+}
+
+// Words returns the long form of this date in Nepali words, as written in
+// legal documents. For example, Shrawan 15, 2081 is
+// "दुई हजार एकासी साल साउन पन्ध्र गते".
+func (t Time) Words() string {
+	return t.WordsIn(Nepali)
+}
+
+// WordsIn returns the long form of this date in words for the provided
+// locale. For example, Shrawan 15, 2081 in English is
+// "the fifteenth day of Shrawan, two thousand eighty-one".
+func (t Time) WordsIn(locale Locale) string {
+	year, day := Numeral(t.year).WordsIn(locale), Numeral(t.day).WordsIn(locale)
+
+	if locale == English {
+		return fmt.Sprintf("the %s day of %s, %s", ordinal(day), monthRomanNames[t.month], year)
+	}
+
+	return fmt.Sprintf("%s साल %s %s गते", year, t.month.Name(), day)
+}
+
+// A scale is a power of ten with its own name, like "lakh" for 100,000.
+type scale struct {
+	value uint64
+	name  string
+}
+
+// The words used to spell out numbers in a locale.
+type words struct {
+	// Words for each number below 100.
+	small [100]string
+
+	// Scales in descending order.
+	scales []scale
+
+	negative, rupees, paisa, and, only string
+}
+
+// numberWords holds the words for each locale used in spelling out numbers.
+var numberWords = map[Locale]words{
+	Nepali: {
+		small: [100]string{
+			"शून्य", "एक", "दुई", "तीन", "चार", "पाँच", "छ", "सात", "आठ", "नौ",
+			"दश", "एघार", "बाह्र", "तेह्र", "चौध", "पन्ध्र", "सोह्र", "सत्र", "अठार", "उन्नाइस",
+			"बीस", "एक्काइस", "बाइस", "तेइस", "चौबीस", "पच्चीस", "छब्बीस", "सत्ताइस", "अट्ठाइस", "उनन्तीस",
+			"तीस", "एकतीस", "बत्तीस", "तेत्तीस", "चौंतीस", "पैंतीस", "छत्तीस", "सैंतीस", "अठतीस", "उनन्चालीस",
+			"चालीस", "एकचालीस", "बयालीस", "त्रिचालीस", "चवालीस", "पैंतालीस", "छयालीस", "सतचालीस", "अठचालीस", "उनन्पचास",
+			"पचास", "एकाउन्न", "बाउन्न", "त्रिपन्न", "चउन्न", "पचपन्न", "छपन्न", "सन्ताउन्न", "अन्ठाउन्न", "उनन्साठी",
+			"साठी", "एकसट्ठी", "बयसट्ठी", "त्रिसट्ठी", "चौंसट्ठी", "पैंसट्ठी", "छयसट्ठी", "सतसट्ठी", "अठसट्ठी", "उनन्सत्तरी",
+			"सत्तरी", "एकहत्तर", "बहत्तर", "त्रिहत्तर", "चौहत्तर", "पचहत्तर", "छयहत्तर", "सतहत्तर", "अठहत्तर", "उनासी",
+			"असी", "एकासी", "बयासी", "त्रियासी", "चौरासी", "पचासी", "छयासी", "सतासी", "अठासी", "उनान्नब्बे",
+			"नब्बे", "एकानब्बे", "बयानब्बे", "त्रियानब्बे", "चौरानब्बे", "पन्चानब्बे", "छयानब्बे", "सन्तानब्बे", "अन्ठानब्बे", "उनान्सय",
+		},
+		scales: []scale{
+			{1e17, "शंख"},
+			{1e15, "पद्म"},
+			{1e13, "नील"},
+			{1e11, "खर्ब"},
+			{1e9, "अर्ब"},
+			{1e7, "करोड"},
+			{1e5, "लाख"},
+			{1e3, "हजार"},
+			{1e2, "सय"},
+		},
+		negative: "ऋण",
+		rupees:   "रुपैयाँ",
+		paisa:    "पैसा",
+		and:      "",
+		only:     "मात्र",
+	},
+	English: {
+		small: englishSmallNumbers(),
+		scales: []scale{
+			{1e17, "shankha"},
+			{1e15, "padma"},
+			{1e13, "neel"},
+			{1e11, "kharab"},
+			{1e9, "arab"},
+			{1e7, "crore"},
+			{1e5, "lakh"},
+			{1e3, "thousand"},
+			{1e2, "hundred"},
+		},
+		negative: "minus",
+		rupees:   "rupees",
+		paisa:    "paisa",
+		and:      " and",
+		only:     "only",
+	},
+}
+
+// spell spells out a positive number by breaking it down into its scales,
+// with the quantity of each scale being spelled out recursively.
+func spell(n uint64, w words) string {
+	var parts []string
+	for _, s := range w.scales {
+		if q := n / s.value; q > 0 {
+			parts = append(parts, spell(q, w), s.name)
+			n %= s.value
+		}
+	}
+
+	if n > 0 {
+		parts = append(parts, w.small[n])
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// englishSmallNumbers generates the English words for numbers below 100.
+func englishSmallNumbers() [100]string {
+	ones := []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tens := []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+	var small [100]string
+	for i := 0; i < 100; i++ {
+		switch {
+		case i < 20:
+			small[i] = ones[i]
+		case i%10 == 0:
+			small[i] = tens[i/10]
+		default:
+			small[i] = tens[i/10] + "-" + ones[i%10]
+		}
+	}
+
+	return small
+}
+
+// ordinal converts English cardinal words into ordinal words, e.g.
+// "twenty-one" into "twenty-first".
+func ordinal(cardinal string) string {
+	irregular := map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+
+	// Only the last word, or the last part of a hyphenated word, changes.
+	i := strings.LastIndexAny(cardinal, " -") + 1
+	head, last := cardinal[:i], cardinal[i:]
+
+	switch {
+	case irregular[last] != "":
+		last = irregular[last]
+	case strings.HasSuffix(last, "y"):
+		last = strings.TrimSuffix(last, "y") + "ieth"
+	default:
+		last += "th"
+	}
+
+	return head + last
+}
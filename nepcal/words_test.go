@@ -0,0 +1,89 @@
+package nepcal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumeralWords(t *testing.T) {
+	tests := []struct {
+		num     int
+		nepali  string
+		english string
+	}{
+		{0, "शून्य", "zero"},
+		{15, "पन्ध्र", "fifteen"},
+		{81, "एकासी", "eighty-one"},
+		{100, "एक सय", "one hundred"},
+		{2081, "दुई हजार एकासी", "two thousand eighty-one"},
+		{250000, "दुई लाख पचास हजार", "two lakh fifty thousand"},
+		{12345678, "एक करोड तेइस लाख पैंतालीस हजार छ सय अठहत्तर", "one crore twenty-three lakh forty-five thousand six hundred seventy-eight"},
+		{1000000000, "एक अर्ब", "one arab"},
+		{-42, "ऋण बयालीस", "minus forty-two"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.english, func(t *testing.T) {
+			assert.Equal(t, test.nepali, Numeral(test.num).Words())
+			assert.Equal(t, test.nepali, Numeral(test.num).WordsIn(Nepali))
+			assert.Equal(t, test.english, Numeral(test.num).WordsIn(English))
+		})
+	}
+
+	t.Run("extremes", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			Numeral(math.MaxInt64).Words()
+			Numeral(math.MinInt64).WordsIn(English)
+		})
+		assert.Equal(t, "दश शंख", Numeral(1e18).Words())
+	})
+}
+
+func TestAmountWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		rupees int
+		paisa  int
+		locale Locale
+		want   string
+		err    error
+	}{
+		{"rupees and paisa", 2000, 50, Nepali, "दुई हजार रुपैयाँ पचास पैसा मात्र", nil},
+		{"rupees and paisa in English", 2000, 50, English, "two thousand rupees and fifty paisa only", nil},
+		{"no paisa", 100000, 0, Nepali, "एक लाख रुपैयाँ मात्र", nil},
+		{"no paisa in English", 100000, 0, English, "one lakh rupees only", nil},
+		{"negative rupees", -1, 0, English, "", ErrInvalidAmount},
+		{"negative paisa", 1, -50, English, "", ErrInvalidAmount},
+		{"paisa over 99", 1, 100, English, "", ErrInvalidAmount},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AmountWords(test.rupees, test.paisa, test.locale)
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestTimeWords(t *testing.T) {
+	tests := []struct {
+		date    Time
+		nepali  string
+		english string
+	}{
+		{DateUnchecked(2081, Shrawan, 15), "दुई हजार एकासी साल साउन पन्ध्र गते", "the fifteenth day of Shrawan, two thousand eighty-one"},
+		{DateUnchecked(2053, Mangshir, 1), "दुई हजार त्रिपन्न साल मंसिर एक गते", "the first day of Mangshir, two thousand fifty-three"},
+		{DateUnchecked(2076, Jestha, 32), "दुई हजार छयहत्तर साल जेठ बत्तीस गते", "the thirty-second day of Jestha, two thousand seventy-six"},
+		{DateUnchecked(2076, Jestha, 20), "दुई हजार छयहत्तर साल जेठ बीस गते", "the twentieth day of Jestha, two thousand seventy-six"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.english, func(t *testing.T) {
+			assert.Equal(t, test.nepali, test.date.Words())
+			assert.Equal(t, test.english, test.date.WordsIn(English))
+		})
+	}
+}
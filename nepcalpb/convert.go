@@ -0,0 +1,53 @@
+package nepcalpb
+
+import (
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// NewBSDate creates the BSDate message for a B.S. date.
+func NewBSDate(t nepcal.Time) *BSDate {
+	yy, mm, dd := t.Date()
+
+	return &BSDate{Year: int32(yy), Month: int32(mm), Day: int32(dd)}
+}
+
+// Time converts the message into a nepcal.Time. An nepcal.ErrOutOfBounds is
+// returned if the date does not exist or is outside the supported range.
+func (d *BSDate) Time() (nepcal.Time, error) {
+	jdn, err := nepcal.BikramSambat.JulianDay(int(d.GetYear()), int(d.GetMonth()), int(d.GetDay()))
+	if err != nil {
+		return nepcal.Time{}, err
+	}
+
+	return nepcal.FromJulianDay(jdn)
+}
+
+// NewADDate creates the ADDate message for the Gregorian date of 't'.
+func NewADDate(t time.Time) *ADDate {
+	yy, mm, dd := t.Date()
+
+	return &ADDate{Year: int32(yy), Month: int32(mm), Day: int32(dd)}
+}
+
+// Time converts the message into a time.Time at midnight UTC. An
+// nepcal.ErrOutOfBounds is returned if the date does not exist.
+func (d *ADDate) Time() (time.Time, error) {
+	yy, mm, dd := int(d.GetYear()), int(d.GetMonth()), int(d.GetDay())
+	if _, err := nepcal.Gregorian.JulianDay(yy, mm, dd); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(yy, time.Month(mm), dd, 0, 0, 0, 0, time.UTC), nil
+}
+
+// NewDate creates the Date message, representing 't' in both calendars.
+func NewDate(t nepcal.Time) *Date {
+	return &Date{
+		Bs:        NewBSDate(t),
+		Ad:        NewADDate(t.Gregorian()),
+		Weekday:   int32(t.Weekday()),
+		JulianDay: int64(t.JulianDay()),
+	}
+}
@@ -0,0 +1,51 @@
+package nepcalpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBSDate(t *testing.T) {
+	bs := nepcal.DateUnchecked(2081, nepcal.Shrawan, 15)
+
+	d := NewBSDate(bs)
+	assert.Equal(t, int32(2081), d.Year)
+	assert.Equal(t, int32(4), d.Month)
+	assert.Equal(t, int32(15), d.Day)
+
+	actual, err := d.Time()
+	assert.NoError(t, err)
+	assert.Equal(t, bs, actual)
+
+	_, err = (&BSDate{Year: 2081, Month: 4, Day: 33}).Time()
+	assert.Equal(t, nepcal.ErrOutOfBounds, err)
+
+	_, err = (*BSDate)(nil).Time()
+	assert.Equal(t, nepcal.ErrOutOfBounds, err)
+}
+
+func TestADDate(t *testing.T) {
+	ad := time.Date(2024, time.July, 30, 0, 0, 0, 0, time.UTC)
+
+	d := NewADDate(ad)
+	assert.Equal(t, &ADDate{Year: 2024, Month: 7, Day: 30}, d)
+
+	actual, err := d.Time()
+	assert.NoError(t, err)
+	assert.Equal(t, ad, actual)
+
+	_, err = (&ADDate{Year: 2019, Month: 2, Day: 29}).Time()
+	assert.Equal(t, nepcal.ErrOutOfBounds, err)
+}
+
+func TestNewDate(t *testing.T) {
+	d := NewDate(nepcal.DateUnchecked(2081, nepcal.Shrawan, 15))
+
+	assert.Equal(t, int32(2081), d.Bs.Year)
+	assert.Equal(t, int32(2024), d.Ad.Year)
+	assert.Equal(t, int32(nepcal.Tuesday), d.Weekday)
+	assert.Equal(t, int64(2460522), d.JulianDay)
+}
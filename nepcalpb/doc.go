@@ -0,0 +1,7 @@
+// Package nepcalpb contains the Protobuf messages and gRPC service for
+// exchanging B.S. dates, generated from nepcal.proto, along with helpers to
+// convert between the messages and nepcal.Time, and an implementation of the
+// Converter service.
+package nepcalpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative nepcal.proto
@@ -0,0 +1,699 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: nepcal.proto
+
+package nepcalpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BSDate is a date in the Bikram Sambat calendar.
+type BSDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Month of the year, from 1 (Baisakh) to 12 (Chaitra).
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Day of the month, from 1 to 32.
+	Day int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (x *BSDate) Reset() {
+	*x = BSDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BSDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BSDate) ProtoMessage() {}
+
+func (x *BSDate) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BSDate.ProtoReflect.Descriptor instead.
+func (*BSDate) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BSDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *BSDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *BSDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// ADDate is a date in the Gregorian calendar.
+type ADDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Month of the year, from 1 (January) to 12 (December).
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Day of the month, from 1 to 31.
+	Day int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (x *ADDate) Reset() {
+	*x = ADDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ADDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ADDate) ProtoMessage() {}
+
+func (x *ADDate) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ADDate.ProtoReflect.Descriptor instead.
+func (*ADDate) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ADDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ADDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ADDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// Date is a single day represented in both calendars.
+type Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bs *BSDate `protobuf:"bytes,1,opt,name=bs,proto3" json:"bs,omitempty"`
+	Ad *ADDate `protobuf:"bytes,2,opt,name=ad,proto3" json:"ad,omitempty"`
+	// Day of the week, from 0 (Sunday) to 6 (Saturday).
+	Weekday int32 `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// Julian Day Number of the day.
+	JulianDay int64 `protobuf:"varint,4,opt,name=julian_day,json=julianDay,proto3" json:"julian_day,omitempty"`
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Date) GetBs() *BSDate {
+	if x != nil {
+		return x.Bs
+	}
+	return nil
+}
+
+func (x *Date) GetAd() *ADDate {
+	if x != nil {
+		return x.Ad
+	}
+	return nil
+}
+
+func (x *Date) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *Date) GetJulianDay() int64 {
+	if x != nil {
+		return x.JulianDay
+	}
+	return 0
+}
+
+type ToBSRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date *ADDate `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *ToBSRequest) Reset() {
+	*x = ToBSRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToBSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToBSRequest) ProtoMessage() {}
+
+func (x *ToBSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToBSRequest.ProtoReflect.Descriptor instead.
+func (*ToBSRequest) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ToBSRequest) GetDate() *ADDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type ToADRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date *BSDate `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *ToADRequest) Reset() {
+	*x = ToADRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToADRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToADRequest) ProtoMessage() {}
+
+func (x *ToADRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToADRequest.ProtoReflect.Descriptor instead.
+func (*ToADRequest) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ToADRequest) GetDate() *BSDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type TodayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TodayRequest) Reset() {
+	*x = TodayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TodayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodayRequest) ProtoMessage() {}
+
+func (x *TodayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodayRequest.ProtoReflect.Descriptor instead.
+func (*TodayRequest) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{5}
+}
+
+type CalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Month of the year, from 1 (Baisakh) to 12 (Chaitra).
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+}
+
+func (x *CalendarRequest) Reset() {
+	*x = CalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarRequest) ProtoMessage() {}
+
+func (x *CalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarRequest.ProtoReflect.Descriptor instead.
+func (*CalendarRequest) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalendarRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalendarRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type CalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Every day of the month, in order.
+	Days []*Date `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// Day of the week that the month starts on, from 0 (Sunday) to 6 (Saturday).
+	StartWeekday int32 `protobuf:"varint,2,opt,name=start_weekday,json=startWeekday,proto3" json:"start_weekday,omitempty"`
+}
+
+func (x *CalendarResponse) Reset() {
+	*x = CalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nepcal_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarResponse) ProtoMessage() {}
+
+func (x *CalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nepcal_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarResponse.ProtoReflect.Descriptor instead.
+func (*CalendarResponse) Descriptor() ([]byte, []int) {
+	return file_nepcal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalendarResponse) GetDays() []*Date {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CalendarResponse) GetStartWeekday() int32 {
+	if x != nil {
+		return x.StartWeekday
+	}
+	return 0
+}
+
+var File_nepcal_proto protoreflect.FileDescriptor
+
+var file_nepcal_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x22, 0x44, 0x0a, 0x06, 0x42, 0x53, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22,
+	0x44, 0x0a, 0x06, 0x41, 0x44, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x02, 0x62, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x70,
+	0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x53, 0x44, 0x61, 0x74, 0x65, 0x52, 0x02, 0x62,
+	0x73, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x44, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x02, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6a, 0x75, 0x6c, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6a, 0x75, 0x6c, 0x69, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x22, 0x34, 0x0a,
+	0x0b, 0x54, 0x6f, 0x42, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x70,
+	0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x44, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x34, 0x0a, 0x0b, 0x54, 0x6f, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x53, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x6f, 0x64,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0x5c, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x32, 0xe5, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x54, 0x6f, 0x42, 0x53, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x70,
+	0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x42, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x54, 0x6f, 0x41, 0x44, 0x12, 0x16, 0x2e, 0x6e, 0x65,
+	0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x17, 0x2e,
+	0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x72, 0x69, 0x73, 0x68,
+	0x61, 0x6e, 0x62, 0x68, 0x61, 0x74, 0x74, 0x61, 0x72, 0x61, 0x69, 0x2f, 0x6e, 0x65, 0x70, 0x63,
+	0x61, 0x6c, 0x2f, 0x6e, 0x65, 0x70, 0x63, 0x61, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nepcal_proto_rawDescOnce sync.Once
+	file_nepcal_proto_rawDescData = file_nepcal_proto_rawDesc
+)
+
+func file_nepcal_proto_rawDescGZIP() []byte {
+	file_nepcal_proto_rawDescOnce.Do(func() {
+		file_nepcal_proto_rawDescData = protoimpl.X.CompressGZIP(file_nepcal_proto_rawDescData)
+	})
+	return file_nepcal_proto_rawDescData
+}
+
+var file_nepcal_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_nepcal_proto_goTypes = []interface{}{
+	(*BSDate)(nil),           // 0: nepcal.v1.BSDate
+	(*ADDate)(nil),           // 1: nepcal.v1.ADDate
+	(*Date)(nil),             // 2: nepcal.v1.Date
+	(*ToBSRequest)(nil),      // 3: nepcal.v1.ToBSRequest
+	(*ToADRequest)(nil),      // 4: nepcal.v1.ToADRequest
+	(*TodayRequest)(nil),     // 5: nepcal.v1.TodayRequest
+	(*CalendarRequest)(nil),  // 6: nepcal.v1.CalendarRequest
+	(*CalendarResponse)(nil), // 7: nepcal.v1.CalendarResponse
+}
+var file_nepcal_proto_depIdxs = []int32{
+	0, // 0: nepcal.v1.Date.bs:type_name -> nepcal.v1.BSDate
+	1, // 1: nepcal.v1.Date.ad:type_name -> nepcal.v1.ADDate
+	1, // 2: nepcal.v1.ToBSRequest.date:type_name -> nepcal.v1.ADDate
+	0, // 3: nepcal.v1.ToADRequest.date:type_name -> nepcal.v1.BSDate
+	2, // 4: nepcal.v1.CalendarResponse.days:type_name -> nepcal.v1.Date
+	3, // 5: nepcal.v1.Converter.ToBS:input_type -> nepcal.v1.ToBSRequest
+	4, // 6: nepcal.v1.Converter.ToAD:input_type -> nepcal.v1.ToADRequest
+	5, // 7: nepcal.v1.Converter.Today:input_type -> nepcal.v1.TodayRequest
+	6, // 8: nepcal.v1.Converter.Calendar:input_type -> nepcal.v1.CalendarRequest
+	2, // 9: nepcal.v1.Converter.ToBS:output_type -> nepcal.v1.Date
+	2, // 10: nepcal.v1.Converter.ToAD:output_type -> nepcal.v1.Date
+	2, // 11: nepcal.v1.Converter.Today:output_type -> nepcal.v1.Date
+	7, // 12: nepcal.v1.Converter.Calendar:output_type -> nepcal.v1.CalendarResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_nepcal_proto_init() }
+func file_nepcal_proto_init() {
+	if File_nepcal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nepcal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BSDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToBSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToADRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TodayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nepcal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nepcal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nepcal_proto_goTypes,
+		DependencyIndexes: file_nepcal_proto_depIdxs,
+		MessageInfos:      file_nepcal_proto_msgTypes,
+	}.Build()
+	File_nepcal_proto = out.File
+	file_nepcal_proto_rawDesc = nil
+	file_nepcal_proto_goTypes = nil
+	file_nepcal_proto_depIdxs = nil
+}
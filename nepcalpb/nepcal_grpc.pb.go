@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: nepcal.proto
+
+package nepcalpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Converter_ToBS_FullMethodName     = "/nepcal.v1.Converter/ToBS"
+	Converter_ToAD_FullMethodName     = "/nepcal.v1.Converter/ToAD"
+	Converter_Today_FullMethodName    = "/nepcal.v1.Converter/Today"
+	Converter_Calendar_FullMethodName = "/nepcal.v1.Converter/Calendar"
+)
+
+// ConverterClient is the client API for Converter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConverterClient interface {
+	// ToBS converts a Gregorian date into B.S.
+	ToBS(ctx context.Context, in *ToBSRequest, opts ...grpc.CallOption) (*Date, error)
+	// ToAD converts a B.S. date into Gregorian.
+	ToAD(ctx context.Context, in *ToADRequest, opts ...grpc.CallOption) (*Date, error)
+	// Today returns today's date in Nepal.
+	Today(ctx context.Context, in *TodayRequest, opts ...grpc.CallOption) (*Date, error)
+	// Calendar returns every day of a B.S. month.
+	Calendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error)
+}
+
+type converterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConverterClient(cc grpc.ClientConnInterface) ConverterClient {
+	return &converterClient{cc}
+}
+
+func (c *converterClient) ToBS(ctx context.Context, in *ToBSRequest, opts ...grpc.CallOption) (*Date, error) {
+	out := new(Date)
+	err := c.cc.Invoke(ctx, Converter_ToBS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterClient) ToAD(ctx context.Context, in *ToADRequest, opts ...grpc.CallOption) (*Date, error) {
+	out := new(Date)
+	err := c.cc.Invoke(ctx, Converter_ToAD_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterClient) Today(ctx context.Context, in *TodayRequest, opts ...grpc.CallOption) (*Date, error) {
+	out := new(Date)
+	err := c.cc.Invoke(ctx, Converter_Today_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *converterClient) Calendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error) {
+	out := new(CalendarResponse)
+	err := c.cc.Invoke(ctx, Converter_Calendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConverterServer is the server API for Converter service.
+// All implementations must embed UnimplementedConverterServer
+// for forward compatibility
+type ConverterServer interface {
+	// ToBS converts a Gregorian date into B.S.
+	ToBS(context.Context, *ToBSRequest) (*Date, error)
+	// ToAD converts a B.S. date into Gregorian.
+	ToAD(context.Context, *ToADRequest) (*Date, error)
+	// Today returns today's date in Nepal.
+	Today(context.Context, *TodayRequest) (*Date, error)
+	// Calendar returns every day of a B.S. month.
+	Calendar(context.Context, *CalendarRequest) (*CalendarResponse, error)
+	mustEmbedUnimplementedConverterServer()
+}
+
+// UnimplementedConverterServer must be embedded to have forward compatible implementations.
+type UnimplementedConverterServer struct {
+}
+
+func (UnimplementedConverterServer) ToBS(context.Context, *ToBSRequest) (*Date, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToBS not implemented")
+}
+func (UnimplementedConverterServer) ToAD(context.Context, *ToADRequest) (*Date, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToAD not implemented")
+}
+func (UnimplementedConverterServer) Today(context.Context, *TodayRequest) (*Date, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Today not implemented")
+}
+func (UnimplementedConverterServer) Calendar(context.Context, *CalendarRequest) (*CalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calendar not implemented")
+}
+func (UnimplementedConverterServer) mustEmbedUnimplementedConverterServer() {}
+
+// UnsafeConverterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConverterServer will
+// result in compilation errors.
+type UnsafeConverterServer interface {
+	mustEmbedUnimplementedConverterServer()
+}
+
+func RegisterConverterServer(s grpc.ServiceRegistrar, srv ConverterServer) {
+	s.RegisterService(&Converter_ServiceDesc, srv)
+}
+
+func _Converter_ToBS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToBSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterServer).ToBS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Converter_ToBS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterServer).ToBS(ctx, req.(*ToBSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Converter_ToAD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToADRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterServer).ToAD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Converter_ToAD_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterServer).ToAD(ctx, req.(*ToADRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Converter_Today_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TodayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterServer).Today(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Converter_Today_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterServer).Today(ctx, req.(*TodayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Converter_Calendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConverterServer).Calendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Converter_Calendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConverterServer).Calendar(ctx, req.(*CalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Converter_ServiceDesc is the grpc.ServiceDesc for Converter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Converter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nepcal.v1.Converter",
+	HandlerType: (*ConverterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ToBS",
+			Handler:    _Converter_ToBS_Handler,
+		},
+		{
+			MethodName: "ToAD",
+			Handler:    _Converter_ToAD_Handler,
+		},
+		{
+			MethodName: "Today",
+			Handler:    _Converter_Today_Handler,
+		},
+		{
+			MethodName: "Calendar",
+			Handler:    _Converter_Calendar_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nepcal.proto",
+}
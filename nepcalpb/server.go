@@ -0,0 +1,96 @@
+package nepcalpb
+
+import (
+	"context"
+	"time"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// converterServer implements the Converter gRPC service.
+type converterServer struct {
+	UnimplementedConverterServer
+
+	// now returns the current time; overridden in tests.
+	now func() time.Time
+}
+
+// NewConverterServer creates the Converter service which determines today's
+// date using 'now'. Register it on a grpc.Server using RegisterConverterServer.
+func NewConverterServer(now func() time.Time) ConverterServer {
+	return &converterServer{now: now}
+}
+
+// ToBS satisfies the ConverterServer interface.
+func (s *converterServer) ToBS(ctx context.Context, req *ToBSRequest) (*Date, error) {
+	if req.GetDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	ad, err := req.GetDate().Time()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date does not exist")
+	}
+
+	bs, err := nepcal.FromGregorian(ad)
+	if err != nil {
+		return nil, status.Error(codes.OutOfRange, "date is out of the supported range")
+	}
+
+	return NewDate(bs), nil
+}
+
+// ToAD satisfies the ConverterServer interface.
+func (s *converterServer) ToAD(ctx context.Context, req *ToADRequest) (*Date, error) {
+	if req.GetDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	if !nepcal.IsInRangeYear(int(req.GetDate().GetYear())) {
+		return nil, status.Error(codes.OutOfRange, "date is out of the supported range")
+	}
+
+	bs, err := req.GetDate().Time()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date does not exist")
+	}
+
+	return NewDate(bs), nil
+}
+
+// Today satisfies the ConverterServer interface. Today is determined in Nepal Time.
+func (s *converterServer) Today(ctx context.Context, req *TodayRequest) (*Date, error) {
+	now := s.now().In(nepcal.NepalTime)
+
+	bs, err := nepcal.FromGregorian(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "today's date is out of the supported range")
+	}
+
+	return NewDate(bs), nil
+}
+
+// Calendar satisfies the ConverterServer interface.
+func (s *converterServer) Calendar(ctx context.Context, req *CalendarRequest) (*CalendarResponse, error) {
+	yy, mm := int(req.GetYear()), nepcal.Month(req.GetMonth())
+	if mm < nepcal.Baisakh || mm > nepcal.Chaitra {
+		return nil, status.Error(codes.InvalidArgument, "month must be between 1 and 12")
+	}
+
+	n, err := mm.NumDays(yy)
+	if err != nil {
+		return nil, status.Error(codes.OutOfRange, "year is out of the supported range")
+	}
+
+	res := &CalendarResponse{
+		StartWeekday: int32(nepcal.DateUnchecked(yy, mm, 1).StartWeekday()),
+	}
+
+	for d := 1; d <= n; d++ {
+		res.Days = append(res.Days, NewDate(nepcal.DateUnchecked(yy, mm, d)))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,93 @@
+package nepcalpb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConverterServer(t *testing.T) {
+	// 20:00 UTC is already the next day in Nepal.
+	now := func() time.Time { return time.Date(2024, time.July, 29, 20, 0, 0, 0, time.UTC) }
+	s := NewConverterServer(now)
+	ctx := context.Background()
+
+	t.Run("today", func(t *testing.T) {
+		d, err := s.Today(ctx, &TodayRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &BSDate{Year: 2081, Month: 4, Day: 15}, d.Bs)
+	})
+
+	t.Run("tobs", func(t *testing.T) {
+		d, err := s.ToBS(ctx, &ToBSRequest{Date: &ADDate{Year: 1994, Month: 8, Day: 21}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &BSDate{Year: 2051, Month: 5, Day: 5}, d.Bs)
+		assert.Equal(t, int32(0), d.Weekday)
+	})
+
+	t.Run("toad", func(t *testing.T) {
+		d, err := s.ToAD(ctx, &ToADRequest{Date: &BSDate{Year: 2053, Month: 8, Day: 18}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &ADDate{Year: 1996, Month: 12, Day: 3}, d.Ad)
+	})
+
+	t.Run("calendar", func(t *testing.T) {
+		res, err := s.Calendar(ctx, &CalendarRequest{Year: 2075, Month: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Days, 31)
+		assert.Equal(t, int32(2), res.StartWeekday)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name string
+			call func() error
+			code codes.Code
+		}{
+			{"tobs missing date", func() error { _, err := s.ToBS(ctx, &ToBSRequest{}); return err }, codes.InvalidArgument},
+			{"tobs invalid date", func() error {
+				_, err := s.ToBS(ctx, &ToBSRequest{Date: &ADDate{Year: 2019, Month: 2, Day: 29}})
+				return err
+			}, codes.InvalidArgument},
+			{"tobs out of range", func() error {
+				_, err := s.ToBS(ctx, &ToBSRequest{Date: &ADDate{Year: 1900, Month: 1, Day: 1}})
+				return err
+			}, codes.OutOfRange},
+			{"toad missing date", func() error { _, err := s.ToAD(ctx, &ToADRequest{}); return err }, codes.InvalidArgument},
+			{"toad invalid date", func() error {
+				_, err := s.ToAD(ctx, &ToADRequest{Date: &BSDate{Year: 2081, Month: 1, Day: 32}})
+				return err
+			}, codes.InvalidArgument},
+			{"toad invalid month", func() error {
+				_, err := s.ToAD(ctx, &ToADRequest{Date: &BSDate{Year: 2081, Month: 13, Day: 1}})
+				return err
+			}, codes.InvalidArgument},
+			{"toad out of range", func() error {
+				_, err := s.ToAD(ctx, &ToADRequest{Date: &BSDate{Year: 2200, Month: 1, Day: 1}})
+				return err
+			}, codes.OutOfRange},
+			{"calendar invalid month", func() error {
+				_, err := s.Calendar(ctx, &CalendarRequest{Year: 2075, Month: 13})
+				return err
+			}, codes.InvalidArgument},
+			{"calendar out of range", func() error {
+				_, err := s.Calendar(ctx, &CalendarRequest{Year: 1900, Month: 1})
+				return err
+			}, codes.OutOfRange},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				assert.Equal(t, test.code, status.Code(test.call()))
+			})
+		}
+	})
+}
@@ -0,0 +1,99 @@
+// Package payroll calculates pay periods on the B.S. calendar. Salaries in
+// Nepal are paid per B.S. month, which has 29 to 32 days, and partial months
+// are prorated using the actual number of days in the month.
+package payroll
+
+import (
+	"github.com/srishanbhattarai/nepcal/businessdays"
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// Period is a pay period from 'Start' to 'End', both inclusive. A period whose
+// Start is after its End is empty: it has no working days, and no one is
+// employed during it.
+type Period struct {
+	Start, End nepcal.Time
+}
+
+// Month returns the pay period for the B.S. month of the year. An ErrOutOfBounds
+// is returned if the year is outside the supported range or the month is invalid.
+func Month(year int, month nepcal.Month) (Period, error) {
+	start, err := nepcal.Date(year, month, 1)
+	if err != nil {
+		return Period{}, err
+	}
+
+	return Period{start, nepcal.DateUnchecked(year, month, start.NumDaysInMonth())}, nil
+}
+
+// Days returns the total number of days in the period, which is zero or
+// negative for an empty period.
+func (p Period) Days() int {
+	return p.End.JulianDay() - p.Start.JulianDay() + 1
+}
+
+// WorkingDays returns the number of business days in the period as per the
+// calendar 'c'.
+func (p Period) WorkingDays(c businessdays.Calendar) int {
+	if p.Start.After(p.End) {
+		return 0
+	}
+
+	n := c.BusinessDaysBetween(p.Start, p.End)
+	if c.IsBusinessDay(p.Start) {
+		n++
+	}
+
+	return n
+}
+
+// Employed returns the part of the period during which an employee who joined
+// on 'joined' and left on 'left' was employed, counting both of those days.
+// The zero Time for 'joined' means that they joined before the period, and for
+// 'left' that they have not left. The boolean is false if they were not
+// employed during any of the period, or the period is empty.
+func (p Period) Employed(joined, left nepcal.Time) (Period, bool) {
+	if p.Start.After(p.End) {
+		return Period{}, false
+	}
+
+	e := p
+	if joined != (nepcal.Time{}) && joined.After(e.Start) {
+		e.Start = joined
+	}
+
+	if left != (nepcal.Time{}) && e.End.After(left) {
+		e.End = left
+	}
+
+	if e.Start.After(e.End) {
+		return Period{}, false
+	}
+
+	return e, true
+}
+
+// Prorate returns the fraction of the period, by the actual number of days,
+// during which an employee who joined on 'joined' and left on 'left' was
+// employed. See Employed for the meaning of the dates. For example, joining on
+// Shrawan 17 of a 32 day Shrawan results in 0.5.
+func (p Period) Prorate(joined, left nepcal.Time) float64 {
+	e, ok := p.Employed(joined, left)
+	if !ok {
+		return 0
+	}
+
+	return float64(e.Days()) / float64(p.Days())
+}
+
+// ProrateWorkingDays is like Prorate, except that only the business days as per
+// the calendar 'c' are counted. It is 0 if the period has no business days.
+func (p Period) ProrateWorkingDays(joined, left nepcal.Time, c businessdays.Calendar) float64 {
+	e, ok := p.Employed(joined, left)
+	total := p.WorkingDays(c)
+	if !ok || total == 0 {
+		return 0
+	}
+
+	return float64(e.WorkingDays(c)) / float64(total)
+}
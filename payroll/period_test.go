@@ -0,0 +1,108 @@
+package payroll
+
+import (
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/businessdays"
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonth(t *testing.T) {
+	tests := []struct {
+		name  string
+		year  int
+		month nepcal.Month
+		end   nepcal.Time
+		days  int
+	}{
+		{"32 days", 2081, nepcal.Shrawan, nepcal.DateUnchecked(2081, nepcal.Shrawan, 32), 32},
+		{"29 days", 2081, nepcal.Falgun, nepcal.DateUnchecked(2081, nepcal.Falgun, 29), 29},
+		{"31 days", 2081, nepcal.Chaitra, nepcal.DateUnchecked(2081, nepcal.Chaitra, 31), 31},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Month(test.year, test.month)
+
+			assert.NoError(t, err)
+			assert.Equal(t, nepcal.DateUnchecked(test.year, test.month, 1), p.Start)
+			assert.Equal(t, test.end, p.End)
+			assert.Equal(t, test.days, p.Days())
+		})
+	}
+
+	t.Run("out of bounds", func(t *testing.T) {
+		_, err := Month(1974, nepcal.Baisakh)
+		assert.Equal(t, nepcal.ErrOutOfBounds, err)
+
+		_, err = Month(2081, 13)
+		assert.Equal(t, nepcal.ErrOutOfBounds, err)
+	})
+}
+
+func TestPeriodDays(t *testing.T) {
+	p := Period{nepcal.DateUnchecked(2081, nepcal.Chaitra, 30), nepcal.DateUnchecked(2082, nepcal.Baisakh, 2)}
+
+	assert.Equal(t, 4, p.Days())
+
+	p, _ = Month(2081, nepcal.Shrawan)
+	assert.Equal(t, 32, p.Days())
+}
+
+func TestEmptyPeriod(t *testing.T) {
+	p := Period{nepcal.DateUnchecked(2081, nepcal.Shrawan, 10), nepcal.DateUnchecked(2081, nepcal.Shrawan, 5)}
+	c := businessdays.New(businessdays.SaturdayWeekend)
+
+	assert.Equal(t, -4, p.Days())
+	assert.Equal(t, 0, p.WorkingDays(c))
+
+	e, ok := p.Employed(nepcal.Time{}, nepcal.Time{})
+	assert.False(t, ok)
+	assert.Equal(t, Period{}, e)
+
+	assert.Equal(t, 0.0, p.Prorate(nepcal.Time{}, nepcal.Time{}))
+	assert.Equal(t, 0.0, p.ProrateWorkingDays(nepcal.Time{}, nepcal.Time{}, c))
+}
+
+func TestWorkingDays(t *testing.T) {
+	p, _ := Month(2081, nepcal.Shrawan)
+
+	assert.Equal(t, 28, p.WorkingDays(businessdays.New(businessdays.SaturdayWeekend)))
+	assert.Equal(t, 24, p.WorkingDays(businessdays.New(businessdays.SaturdaySundayWeekend)))
+	assert.Equal(t, 27, p.WorkingDays(businessdays.New(businessdays.SaturdayWeekend, businessdays.NewDates(nepcal.DateUnchecked(2081, nepcal.Shrawan, 1)))))
+}
+
+func TestProrate(t *testing.T) {
+	p, _ := Month(2081, nepcal.Shrawan)
+	c := businessdays.New(businessdays.SaturdayWeekend)
+
+	tests := []struct {
+		name        string
+		joined      nepcal.Time
+		left        nepcal.Time
+		employed    Period
+		fraction    float64
+		workingDays float64
+	}{
+		{"whole month", nepcal.Time{}, nepcal.Time{}, p, 1, 1},
+		{"joined earlier", nepcal.DateUnchecked(2080, nepcal.Baisakh, 1), nepcal.Time{}, p, 1, 1},
+		{"joined", nepcal.DateUnchecked(2081, nepcal.Shrawan, 17), nepcal.Time{}, Period{nepcal.DateUnchecked(2081, nepcal.Shrawan, 17), nepcal.DateUnchecked(2081, nepcal.Shrawan, 32)}, 0.5, 0.5},
+		{"left", nepcal.Time{}, nepcal.DateUnchecked(2081, nepcal.Shrawan, 8), Period{nepcal.DateUnchecked(2081, nepcal.Shrawan, 1), nepcal.DateUnchecked(2081, nepcal.Shrawan, 8)}, 0.25, 7.0 / 28},
+		{"joined and left", nepcal.DateUnchecked(2081, nepcal.Shrawan, 10), nepcal.DateUnchecked(2081, nepcal.Shrawan, 13), Period{nepcal.DateUnchecked(2081, nepcal.Shrawan, 10), nepcal.DateUnchecked(2081, nepcal.Shrawan, 13)}, 0.125, 3.0 / 28},
+		{"joined and left on the same day", nepcal.DateUnchecked(2081, nepcal.Shrawan, 5), nepcal.DateUnchecked(2081, nepcal.Shrawan, 5), Period{nepcal.DateUnchecked(2081, nepcal.Shrawan, 5), nepcal.DateUnchecked(2081, nepcal.Shrawan, 5)}, 1.0 / 32, 0},
+		{"joined later", nepcal.DateUnchecked(2081, nepcal.Bhadra, 1), nepcal.Time{}, Period{}, 0, 0},
+		{"left earlier", nepcal.Time{}, nepcal.DateUnchecked(2081, nepcal.Ashar, 31), Period{}, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, ok := p.Employed(test.joined, test.left)
+
+			assert.Equal(t, test.employed != (Period{}), ok)
+			assert.Equal(t, test.employed, e)
+			assert.InDelta(t, test.fraction, p.Prorate(test.joined, test.left), 1e-9)
+			assert.InDelta(t, test.workingDays, p.ProrateWorkingDays(test.joined, test.left, c), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Package recur evaluates recurrence rules on the B.S. calendar, such as "the
+// 1st of every month" or "every Ashar 15", which can not be expressed with
+// Gregorian recurrence rules. Rules are modelled after the RRULE of RFC 5545
+// and have a similar text form, e.g. "FREQ=MONTHLY;BYMONTHDAY=1", except that
+// months, days and dates are all B.S. values.
+package recur
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// ErrInvalidRule is the error returned for invalid recurrence rules. It is
+// wrapped along with a description of the problem.
+var ErrInvalidRule = errors.New("Invalid recurrence rule")
+
+// Frequency is the period on which a rule recurs.
+type Frequency int
+
+// Supported frequencies.
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+// LastDay is the BYMONTHDAY value for the last day of the month. In general,
+// negative days count back from the end of the month, i.e. -2 is the day
+// before the last day.
+const LastDay = -1
+
+// Rule is a recurrence rule evaluated on B.S. dates. The zero values of the
+// optional fields mean that they are not used.
+//
+// Occurrences are found the same way as RFC 5545: each period of the frequency
+// (every 'Interval' days, weeks, months or years from the start date) is
+// expanded into the matching days, which are then limited by the remaining
+// parts of the rule. For example, a monthly rule with ByMonthDay expands each
+// month into those days, while a daily rule with ByMonthDay only keeps the days
+// that match. Parts not given are taken from the start date, i.e. a yearly rule
+// without ByMonth and ByMonthDay recurs on the month and day of the start date.
+// Days that do not exist in a month, such as the 32nd of a 31 day month, are
+// skipped. Weeks start on Sunday.
+type Rule struct {
+	Freq Frequency
+
+	// Interval between the periods of the frequency; 0 is the same as 1.
+	Interval int
+
+	// The months of the year, days of the month (1 to 32, or -32 to -1 counting
+	// back from the end of the month) and days of the week to recur on.
+	ByMonth    []nepcal.Month
+	ByMonthDay []int
+	ByWeekday  []nepcal.Weekday
+
+	// The number of occurrences, or the last date that occurrences can be on.
+	// At most one of them may be set.
+	Count int
+	Until nepcal.Time
+}
+
+// Validate checks that the parts of the rule are in their ranges. The returned
+// error wraps ErrInvalidRule.
+func (r Rule) Validate() error {
+	invalid := func(format string, a ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidRule, fmt.Sprintf(format, a...))
+	}
+
+	if r.Freq < Daily || r.Freq > Yearly {
+		return invalid("unknown frequency %d", r.Freq)
+	}
+
+	if r.Interval < 0 {
+		return invalid("negative interval %d", r.Interval)
+	}
+
+	for _, m := range r.ByMonth {
+		if m < nepcal.Baisakh || m > nepcal.Chaitra {
+			return invalid("month %d", m)
+		}
+	}
+
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -32 || d > 32 {
+			return invalid("day of the month %d", d)
+		}
+	}
+
+	for _, w := range r.ByWeekday {
+		if w < nepcal.Sunday || w > nepcal.Saturday {
+			return invalid("weekday %d", w)
+		}
+	}
+
+	if r.Count < 0 {
+		return invalid("negative count %d", r.Count)
+	}
+
+	if r.Count > 0 && r.hasUntil() {
+		return invalid("both COUNT and UNTIL are set")
+	}
+
+	return nil
+}
+
+// hasUntil reports if the rule has an end date.
+func (r Rule) hasUntil() bool {
+	return r.Until != nepcal.Time{}
+}
+
+// Iter returns an iterator over the occurrences of the rule on or after 'start'.
+// An error wrapping ErrInvalidRule is returned if the rule is invalid.
+func (r Rule) Iter(start nepcal.Time) (*Iterator, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+
+	// Copy the months so that sorting them does not modify the caller's rule;
+	// the occurrences within a year are generated in the order of its months.
+	r.ByMonth = append([]nepcal.Month{}, r.ByMonth...)
+	sort.Slice(r.ByMonth, func(i, j int) bool { return r.ByMonth[i] < r.ByMonth[j] })
+
+	return &Iterator{rule: r, start: start}, nil
+}
+
+// Between returns the occurrences of the rule starting at 'start' that fall
+// between 'from' and 'to', both inclusive.
+func (r Rule) Between(start, from, to nepcal.Time) ([]nepcal.Time, error) {
+	it, err := r.Iter(start)
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []nepcal.Time
+	for {
+		t, ok := it.Next()
+		if !ok || t.After(to) {
+			return occurrences, nil
+		}
+
+		if !from.After(t) {
+			occurrences = append(occurrences, t)
+		}
+	}
+}
+
+// Iterator iterates over the occurrences of a rule in order.
+type Iterator struct {
+	rule  Rule
+	start nepcal.Time
+
+	// The index of the next period, the occurrences of the current period that
+	// are yet to be returned, and the number of occurrences returned so far.
+	period  int
+	pending []nepcal.Time
+	count   int
+
+	done bool
+}
+
+// Next returns the next occurrence. The boolean is false once there are no more
+// occurrences, either because of the COUNT or UNTIL of the rule or because the
+// end of the supported date range has been reached.
+func (it *Iterator) Next() (nepcal.Time, bool) {
+	for !it.done {
+		if len(it.pending) == 0 {
+			var ok bool
+			if it.pending, ok = it.expand(it.period); !ok {
+				it.done = true
+
+				break
+			}
+
+			it.period++
+
+			continue
+		}
+
+		t := it.pending[0]
+		it.pending = it.pending[1:]
+
+		if it.start.After(t) {
+			continue
+		}
+
+		if it.rule.hasUntil() && t.After(it.rule.Until) {
+			it.done = true
+
+			break
+		}
+
+		it.count++
+		if it.rule.Count > 0 && it.count >= it.rule.Count {
+			it.done = true
+		}
+
+		return t, true
+	}
+
+	return nepcal.Time{}, false
+}
+
+// expand returns the occurrences within the period with index 'k', in order.
+// The boolean is false if the period is outside the supported date range.
+func (it *Iterator) expand(k int) ([]nepcal.Time, bool) {
+	r, start := it.rule, it.start
+
+	switch r.Freq {
+	case Yearly:
+		y := start.Year() + k*r.Interval
+		if !nepcal.IsInRangeYear(y) {
+			return nil, false
+		}
+
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByMonthDay) == 0 && len(r.ByWeekday) == 0 {
+				months = []nepcal.Month{start.Month()}
+			} else {
+				months = allMonths
+			}
+		}
+
+		var days []nepcal.Time
+		for _, m := range months {
+			days = append(days, it.expandMonth(y, m)...)
+		}
+
+		return days, true
+	case Monthly:
+		i := start.Year()*12 + int(start.Month()) - 1 + k*r.Interval
+		y, m := i/12, nepcal.Month(i%12+1)
+		if !nepcal.IsInRangeYear(y) {
+			return nil, false
+		}
+
+		if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, m) {
+			return nil, true
+		}
+
+		return it.expandMonth(y, m), true
+	case Weekly:
+		// The Sunday that starts the week of the period.
+		first := start.JulianDay() - int(start.Weekday()) + 7*k*r.Interval
+
+		weekdays := r.ByWeekday
+		if len(weekdays) == 0 {
+			weekdays = []nepcal.Weekday{start.Weekday()}
+		}
+
+		inRange := false
+		var days []nepcal.Time
+		for i := 0; i < 7; i++ {
+			t, err := nepcal.FromJulianDay(first + i)
+			if err != nil {
+				continue
+			}
+
+			inRange = true
+			if containsWeekday(weekdays, t.Weekday()) && it.matchesMonth(t) && matchesMonthDay(r.ByMonthDay, t) {
+				days = append(days, t)
+			}
+		}
+
+		return days, inRange
+	default:
+		t, err := start.AddDays(k * r.Interval)
+		if err != nil {
+			return nil, false
+		}
+
+		if !it.matchesMonth(t) || !matchesMonthDay(r.ByMonthDay, t) || (len(r.ByWeekday) > 0 && !containsWeekday(r.ByWeekday, t.Weekday())) {
+			return nil, true
+		}
+
+		return []nepcal.Time{t}, true
+	}
+}
+
+// expandMonth returns the days of the month 'm' of year 'y' that match the days
+// of the month and weekdays of the rule, or the day of the month of the start
+// date if the rule has neither.
+func (it *Iterator) expandMonth(y int, m nepcal.Month) []nepcal.Time {
+	r := it.rule
+
+	if len(r.ByMonthDay) == 0 && len(r.ByWeekday) == 0 {
+		t, err := nepcal.Date(y, m, it.start.Day())
+		if err != nil {
+			return nil
+		}
+
+		return []nepcal.Time{t}
+	}
+
+	n, err := m.NumDays(y)
+	if err != nil {
+		return nil
+	}
+
+	var days []nepcal.Time
+	for d := 1; d <= n; d++ {
+		t := nepcal.DateUnchecked(y, m, d)
+
+		if matchesMonthDay(r.ByMonthDay, t) && (len(r.ByWeekday) == 0 || containsWeekday(r.ByWeekday, t.Weekday())) {
+			days = append(days, t)
+		}
+	}
+
+	return days
+}
+
+// matchesMonth reports if 't' is in one of the months of the rule, if it has any.
+func (it *Iterator) matchesMonth(t nepcal.Time) bool {
+	return len(it.rule.ByMonth) == 0 || containsMonth(it.rule.ByMonth, t.Month())
+}
+
+// matchesMonthDay reports if 't' is one of the days of the month in 'days',
+// counting negative days back from the end of the month. An empty list matches
+// every day.
+func matchesMonthDay(days []int, t nepcal.Time) bool {
+	if len(days) == 0 {
+		return true
+	}
+
+	for _, d := range days {
+		if d == t.Day() || d == t.Day()-t.NumDaysInMonth()-1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsMonth(months []nepcal.Month, m nepcal.Month) bool {
+	for _, month := range months {
+		if month == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsWeekday(weekdays []nepcal.Weekday, w nepcal.Weekday) bool {
+	for _, weekday := range weekdays {
+		if weekday == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allMonths are the months of a B.S. year in order.
+var allMonths = []nepcal.Month{
+	nepcal.Baisakh, nepcal.Jestha, nepcal.Ashar, nepcal.Shrawan, nepcal.Bhadra, nepcal.Ashoj,
+	nepcal.Kartik, nepcal.Mangshir, nepcal.Poush, nepcal.Magh, nepcal.Falgun, nepcal.Chaitra,
+}
@@ -0,0 +1,169 @@
+package recur
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+// date is shorthand for a B.S. date in the tests.
+func date(y int, m nepcal.Month, d int) nepcal.Time {
+	return nepcal.DateUnchecked(y, m, d)
+}
+
+// occurrences returns up to 'n' occurrences of the rule from 'start' as ISO dates.
+func occurrences(t *testing.T, r Rule, start nepcal.Time, n int) []string {
+	it, err := r.Iter(start)
+	assert.NoError(t, err)
+
+	dates := []string{}
+	for len(dates) < n {
+		o, ok := it.Next()
+		if !ok {
+			break
+		}
+
+		dates = append(dates, o.Format(nepcal.ISODate))
+	}
+
+	return dates
+}
+
+func TestRuleOccurrences(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     Rule
+		start    nepcal.Time
+		expected []string
+	}{
+		{
+			"1st of every month",
+			Rule{Freq: Monthly, ByMonthDay: []int{1}},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-05-01", "2081-06-01", "2081-07-01", "2081-08-01"},
+		},
+		{
+			"every Ashar 15",
+			Rule{Freq: Yearly, ByMonth: []nepcal.Month{nepcal.Ashar}, ByMonthDay: []int{15}},
+			date(2080, nepcal.Baisakh, 1),
+			[]string{"2080-03-15", "2081-03-15", "2082-03-15", "2083-03-15"},
+		},
+		{
+			"last day of every month",
+			Rule{Freq: Monthly, ByMonthDay: []int{LastDay}},
+			date(2081, nepcal.Baisakh, 1),
+			[]string{"2081-01-31", "2081-02-32", "2081-03-31", "2081-04-32"},
+		},
+		{
+			"days missing from some months are skipped",
+			Rule{Freq: Monthly, ByMonthDay: []int{32}},
+			date(2081, nepcal.Baisakh, 1),
+			[]string{"2081-02-32", "2081-04-32", "2082-03-32", "2083-03-32"},
+		},
+		{
+			"every other Saturday",
+			Rule{Freq: Weekly, Interval: 2, ByWeekday: []nepcal.Weekday{nepcal.Saturday}},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-04-19", "2081-05-01", "2081-05-15", "2081-05-29"},
+		},
+		{
+			"weekly on the weekday of the start date",
+			Rule{Freq: Weekly},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-04-15", "2081-04-22", "2081-04-29", "2081-05-04"},
+		},
+		{
+			"every 10 days",
+			Rule{Freq: Daily, Interval: 10},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-04-15", "2081-04-25", "2081-05-03", "2081-05-13"},
+		},
+		{
+			"daily limited to Fridays in Chaitra",
+			Rule{Freq: Daily, ByMonth: []nepcal.Month{nepcal.Chaitra}, ByWeekday: []nepcal.Weekday{nepcal.Friday}},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-12-01", "2081-12-08", "2081-12-15", "2081-12-22"},
+		},
+		{
+			"yearly on the start date",
+			Rule{Freq: Yearly},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-04-15", "2082-04-15", "2083-04-15", "2084-04-15"},
+		},
+		{
+			"monthly limited to some months",
+			Rule{Freq: Monthly, ByMonth: []nepcal.Month{nepcal.Kartik, nepcal.Baisakh}, ByMonthDay: []int{1}},
+			date(2081, nepcal.Baisakh, 1),
+			[]string{"2081-01-01", "2081-07-01", "2082-01-01", "2082-07-01"},
+		},
+		{
+			"count",
+			Rule{Freq: Monthly, ByMonthDay: []int{1}, Count: 2},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-05-01", "2081-06-01"},
+		},
+		{
+			"until",
+			Rule{Freq: Monthly, ByMonthDay: []int{1}, Until: date(2081, nepcal.Ashoj, 1)},
+			date(2081, nepcal.Shrawan, 15),
+			[]string{"2081-05-01", "2081-06-01"},
+		},
+		{
+			"end of the supported range",
+			Rule{Freq: Yearly, ByMonth: []nepcal.Month{nepcal.Chaitra}, ByMonthDay: []int{LastDay}},
+			date(2098, nepcal.Baisakh, 1),
+			[]string{"2098-12-31", "2099-12-30", "2100-12-30"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, occurrences(t, test.rule, test.start, 4))
+		})
+	}
+}
+
+func TestRuleBetween(t *testing.T) {
+	r := Rule{Freq: Monthly, ByMonthDay: []int{1, 15}}
+
+	dates, err := r.Between(date(2081, nepcal.Baisakh, 1), date(2081, nepcal.Shrawan, 1), date(2081, nepcal.Bhadra, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []nepcal.Time{date(2081, nepcal.Shrawan, 1), date(2081, nepcal.Shrawan, 15), date(2081, nepcal.Bhadra, 1)}, dates)
+}
+
+func TestRuleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     Rule
+		expected string
+	}{
+		{"frequency", Rule{}, "unknown frequency 0"},
+		{"interval", Rule{Freq: Daily, Interval: -1}, "negative interval -1"},
+		{"month", Rule{Freq: Yearly, ByMonth: []nepcal.Month{13}}, "month 13"},
+		{"day of the month", Rule{Freq: Monthly, ByMonthDay: []int{0}}, "day of the month 0"},
+		{"weekday", Rule{Freq: Weekly, ByWeekday: []nepcal.Weekday{7}}, "weekday 7"},
+		{"count", Rule{Freq: Daily, Count: -1}, "negative count -1"},
+		{"count and until", Rule{Freq: Daily, Count: 1, Until: date(2081, nepcal.Baisakh, 1)}, "both COUNT and UNTIL are set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.rule.Validate()
+			assert.True(t, errors.Is(err, ErrInvalidRule))
+			assert.EqualError(t, err, "Invalid recurrence rule: "+test.expected)
+
+			_, err = test.rule.Iter(date(2081, nepcal.Baisakh, 1))
+			assert.Equal(t, test.rule.Validate(), err)
+		})
+	}
+}
+
+func TestIterDoesNotModifyRule(t *testing.T) {
+	r := Rule{Freq: Yearly, ByMonth: []nepcal.Month{nepcal.Chaitra, nepcal.Baisakh}}
+
+	_, err := r.Iter(date(2081, nepcal.Baisakh, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []nepcal.Month{nepcal.Chaitra, nepcal.Baisakh}, r.ByMonth)
+}
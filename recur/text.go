@@ -0,0 +1,210 @@
+package recur
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+)
+
+// Names of the frequencies in the text form of rules.
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+	Yearly:  "YEARLY",
+}
+
+// Two letter codes of the weekdays in the text form of rules.
+var weekdayCodes = map[nepcal.Weekday]string{
+	nepcal.Sunday:    "SU",
+	nepcal.Monday:    "MO",
+	nepcal.Tuesday:   "TU",
+	nepcal.Wednesday: "WE",
+	nepcal.Thursday:  "TH",
+	nepcal.Friday:    "FR",
+	nepcal.Saturday:  "SA",
+}
+
+// String returns the text form of the rule, modelled after the RRULE of RFC
+// 5545, e.g. "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=15" for every Ashar 15. Months
+// are B.S. month numbers and UNTIL is a B.S. date in the form YYYYMMDD.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.Freq]}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByMonth) > 0 {
+		months := make([]string, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			months[i] = strconv.Itoa(int(m))
+		}
+
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByWeekday) > 0 {
+		weekdays := make([]string, len(r.ByWeekday))
+		for i, w := range r.ByWeekday {
+			weekdays[i] = weekdayCodes[w]
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(weekdays, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.hasUntil() {
+		y, m, d := r.Until.Date()
+		parts = append(parts, fmt.Sprintf("UNTIL=%04d%02d%02d", y, m, d))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Parse parses the text form of a rule as returned by Rule.String. Parts may be
+// in any order and an "RRULE:" prefix is allowed. BYMONTHDAY also accepts
+// "LAST" for the last day of the month. An error wrapping ErrInvalidRule is
+// returned if the text is not a valid rule.
+func Parse(s string) (Rule, error) {
+	invalid := func(format string, a ...interface{}) (Rule, error) {
+		return Rule{}, fmt.Errorf("%w: %s", ErrInvalidRule, fmt.Sprintf(format, a...))
+	}
+
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+
+	var r Rule
+	seen := map[string]bool{}
+
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return invalid("%q is not of the form NAME=VALUE", part)
+		}
+
+		name, value := kv[0], kv[1]
+		if seen[name] {
+			return invalid("%s is repeated", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq, err = parseFrequency(value)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "BYMONTH":
+			err = parseList(value, func(v string) error {
+				m, err := strconv.Atoi(v)
+				r.ByMonth = append(r.ByMonth, nepcal.Month(m))
+
+				return err
+			})
+		case "BYMONTHDAY":
+			err = parseList(value, func(v string) error {
+				if v == "LAST" {
+					r.ByMonthDay = append(r.ByMonthDay, LastDay)
+
+					return nil
+				}
+
+				d, err := strconv.Atoi(v)
+				r.ByMonthDay = append(r.ByMonthDay, d)
+
+				return err
+			})
+		case "BYDAY":
+			err = parseList(value, func(v string) error {
+				w, err := parseWeekday(v)
+				r.ByWeekday = append(r.ByWeekday, w)
+
+				return err
+			})
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		default:
+			return invalid("unknown part %s", name)
+		}
+
+		if err != nil {
+			return invalid("invalid %s %q", name, value)
+		}
+	}
+
+	if !seen["FREQ"] {
+		return invalid("FREQ is required")
+	}
+
+	if err := r.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	return r, nil
+}
+
+// parseList calls 'fn' with each value of a comma separated list, stopping at the first error.
+func parseList(s string, fn func(string) error) error {
+	for _, v := range strings.Split(s, ",") {
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseFrequency(s string) (Frequency, error) {
+	for f, name := range frequencyNames {
+		if name == s {
+			return f, nil
+		}
+	}
+
+	return 0, ErrInvalidRule
+}
+
+func parseWeekday(s string) (nepcal.Weekday, error) {
+	for w, code := range weekdayCodes {
+		if code == s {
+			return w, nil
+		}
+	}
+
+	return 0, ErrInvalidRule
+}
+
+// parseUntil parses a B.S. date in the form YYYYMMDD.
+func parseUntil(s string) (nepcal.Time, error) {
+	if len(s) != 8 {
+		return nepcal.Time{}, ErrInvalidRule
+	}
+
+	var parts [3]int
+	for i, field := range []string{s[:4], s[4:6], s[6:]} {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nepcal.Time{}, err
+		}
+
+		parts[i] = n
+	}
+
+	return nepcal.Date(parts[0], nepcal.Month(parts[1]), parts[2])
+}
@@ -0,0 +1,91 @@
+package recur
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/srishanbhattarai/nepcal/nepcal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleString(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     Rule
+		expected string
+	}{
+		{"1st of every month", Rule{Freq: Monthly, ByMonthDay: []int{1}}, "FREQ=MONTHLY;BYMONTHDAY=1"},
+		{"every Ashar 15", Rule{Freq: Yearly, ByMonth: []nepcal.Month{nepcal.Ashar}, ByMonthDay: []int{15}}, "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=15"},
+		{"interval of 1", Rule{Freq: Daily, Interval: 1}, "FREQ=DAILY"},
+		{
+			"every other weekend",
+			Rule{Freq: Weekly, Interval: 2, ByWeekday: []nepcal.Weekday{nepcal.Friday, nepcal.Saturday}, Count: 10},
+			"FREQ=WEEKLY;INTERVAL=2;BYDAY=FR,SA;COUNT=10",
+		},
+		{
+			"last day until",
+			Rule{Freq: Monthly, ByMonthDay: []int{LastDay}, Until: date(2082, nepcal.Chaitra, 30)},
+			"FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20821230",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.rule.String())
+
+			r, err := Parse(test.expected)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, r.String())
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		expected Rule
+	}{
+		{"prefix and case", "rrule:freq=monthly;bymonthday=1", Rule{Freq: Monthly, ByMonthDay: []int{1}}},
+		{"any order", "BYMONTHDAY=15;BYMONTH=3;FREQ=YEARLY", Rule{Freq: Yearly, ByMonth: []nepcal.Month{nepcal.Ashar}, ByMonthDay: []int{15}}},
+		{"last day", "FREQ=MONTHLY;BYMONTHDAY=1,LAST", Rule{Freq: Monthly, ByMonthDay: []int{1, LastDay}}},
+		{"until", "FREQ=DAILY;UNTIL=20810415", Rule{Freq: Daily, Until: date(2081, nepcal.Shrawan, 15)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Parse(test.s)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, r)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		expected string
+	}{
+		{"empty", "", `"" is not of the form NAME=VALUE`},
+		{"missing frequency", "BYMONTHDAY=1", "FREQ is required"},
+		{"unknown frequency", "FREQ=HOURLY", `invalid FREQ "HOURLY"`},
+		{"unknown part", "FREQ=DAILY;BYSETPOS=1", "unknown part BYSETPOS"},
+		{"repeated part", "FREQ=DAILY;FREQ=WEEKLY", "FREQ is repeated"},
+		{"invalid weekday", "FREQ=WEEKLY;BYDAY=XX", `invalid BYDAY "XX"`},
+		{"invalid number", "FREQ=DAILY;COUNT=ten", `invalid COUNT "TEN"`},
+		{"invalid until", "FREQ=DAILY;UNTIL=20811332", `invalid UNTIL "20811332"`},
+		{"out of range", "FREQ=MONTHLY;BYMONTHDAY=33", "day of the month 33"},
+		{"count and until", "FREQ=DAILY;COUNT=1;UNTIL=20810415", "both COUNT and UNTIL are set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.s)
+
+			assert.True(t, errors.Is(err, ErrInvalidRule))
+			assert.EqualError(t, err, "Invalid recurrence rule: "+test.expected)
+		})
+	}
+}